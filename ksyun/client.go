@@ -2,6 +2,7 @@ package ksyun
 
 import (
 	"github.com/KscSDK/ksc-sdk-go/service/bws"
+	"github.com/KscSDK/ksc-sdk-go/service/dedicated"
 	"github.com/KscSDK/ksc-sdk-go/service/ebs"
 	"github.com/KscSDK/ksc-sdk-go/service/eip"
 	"github.com/KscSDK/ksc-sdk-go/service/epc"
@@ -25,6 +26,7 @@ import (
 	"github.com/KscSDK/ksc-sdk-go/service/tag"
 	"github.com/KscSDK/ksc-sdk-go/service/tagv2"
 	"github.com/KscSDK/ksc-sdk-go/service/vpc"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
 )
 
@@ -56,6 +58,12 @@ type KsyunClient struct {
 	pdnsconn      *pdns.Pdns           `json:"pdnsconn,omitempty"`
 	kcrsconn      *kcrs.Kcrs           `json:"kcrsconn,omitempty"`
 	kpfsconn      *kpfs.Kpfs           `json:"kpfsconn,omitempty"`
+	dedicatedconn *dedicated.Dedicated
+	tradeconn     *client.Client
+	kafkaconn     *client.Client
+	kmsconn       *client.Client
+	sslconn       *client.Client
+	auditconn     *client.Client
 
 	config *Config
 }
@@ -74,3 +82,14 @@ func (client *KsyunClient) GetEipClient() *eip.Eip {
 func (client *KsyunClient) GetIamClient() *iam.Iam {
 	return client.iamconn
 }
+
+// WithRegion returns a client with connections of another region,
+// it is used by the resources which operate across regions.
+func (client *KsyunClient) WithRegion(region string) (*KsyunClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+	c := *client.config
+	c.Region = region
+	return c.Client()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/KscSDK/ksc-sdk-go/ksc"
 	"github.com/KscSDK/ksc-sdk-go/ksc/utils"
 	"github.com/KscSDK/ksc-sdk-go/service/bws"
+	"github.com/KscSDK/ksc-sdk-go/service/dedicated"
 	"github.com/KscSDK/ksc-sdk-go/service/ebs"
 	"github.com/KscSDK/ksc-sdk-go/service/eip"
 	"github.com/KscSDK/ksc-sdk-go/service/epc"
@@ -102,6 +103,12 @@ func (c *Config) Client() (*KsyunClient, error) {
 	client.pdnsconn = pdns.SdkNew(cli, cfg, url)
 	client.kcrsconn = kcrs.SdkNew(cli, cfg, url)
 	client.kpfsconn = kpfs.SdkNew(cli, cfg, url)
+	client.dedicatedconn = dedicated.SdkNew(cli, cfg, url)
+	client.tradeconn = newKsyunOpenApiClient(cli, cfg, url, "trade", "2020-01-14")
+	client.kafkaconn = newKsyunOpenApiClient(cli, cfg, url, "kafka", "2022-08-08")
+	client.kmsconn = newKsyunOpenApiClient(cli, cfg, url, "kms", "2016-03-04")
+	client.sslconn = newKsyunOpenApiClient(cli, cfg, url, "ssl", "2022-04-01")
+	client.auditconn = newKsyunOpenApiClient(cli, cfg, url, "actiontrail", "2019-04-01")
 
 	// 懒加载ks3-client 所以不在此初始
 	return &client, nil
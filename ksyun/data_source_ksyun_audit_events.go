@@ -0,0 +1,180 @@
+/*
+This data source provides a list of the recent API events of the account, which are recorded by the audit service.
+
+The events of the last 7 days are returned by default, the audit service keeps the events for 90 days.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_audit_events" "default" {
+	  output_file = "output_result"
+	  resource_id = ksyun_instance.default.id
+	  event_name  = "ModifyInstanceAttribute"
+	  start_time  = "2024-06-01T00:00:00Z"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunAuditEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunAuditEventsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the resource which the events operate on.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the resource which the events operate on, such as `Instance`.",
+			},
+			"event_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the events, which is the API action, such as `RunInstances`.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the user who calls the API.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				Description:  "The start of the time range in RFC3339 format. Default is 7 days before `end_time`.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				Description:  "The end of the time range in RFC3339 format. Default is now.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "The max number of the events to return, the latest events are returned first. Default is `100`.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by event name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of events that satisfy the condition.",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of the events.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the event.",
+						},
+						"event_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the event.",
+						},
+						"event_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the event happens.",
+						},
+						"event_source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service of the API, such as `kec`.",
+						},
+						"event_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the event, `Read` or `Write`.",
+						},
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the user who calls the API.",
+						},
+						"source_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ip address where the API is called from.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the event.",
+						},
+						"request_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The request id of the API call.",
+						},
+						"error_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error code if the API call fails.",
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error message if the API call fails.",
+						},
+						"resources": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resources which the event operates on.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the resource.",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the resource.",
+									},
+									"resource_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the resource.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunAuditEventsRead(d *schema.ResourceData, meta interface{}) error {
+	auditService := AuditService{meta.(*KsyunClient)}
+	return auditService.ReadAndSetAuditEvents(d, dataSourceKsyunAuditEvents())
+}
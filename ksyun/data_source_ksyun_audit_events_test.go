@@ -0,0 +1,37 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunAuditEventsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataAuditEventsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_audit_events.default"),
+					resource.TestCheckResourceAttrSet("data.ksyun_audit_events.default", "events.0.event_id"),
+					resource.TestCheckResourceAttr("data.ksyun_audit_events.default", "events.0.resources.0.resource_id", "bucket-tf-acc-audit-events"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataAuditEventsConfig = `
+resource "ksyun_ks3_bucket" "default" {
+  bucket = "bucket-tf-acc-audit-events"
+}
+
+data "ksyun_audit_events" "default" {
+  output_file = "output_result"
+  resource_id = ksyun_ks3_bucket.default.id
+  max_results = 10
+}`
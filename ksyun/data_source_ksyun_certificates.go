@@ -1,5 +1,5 @@
 /*
-This data source provides a list of Certificate resources (KCM) according to their ID.
+This data source provides a list of Certificate resources (KCM) according to their ID, domain and days to expiry.
 
 Example Usage
 
@@ -8,6 +8,12 @@ data "ksyun_certificates" "default" {
   output_file="output_result"
   ids = ["c7b2ba05-9302-4933-8588-a66f920ff57d"]
 }
+
+# the certificates of www.example.com which expire in 30 days
+data "ksyun_certificates" "expiring" {
+  domain             = "www.example.com"
+  expire_within_days = 30
+}
 ```
 */
 
@@ -37,6 +43,16 @@ func dataSourceKsyunCertificates() *schema.Resource {
 				ValidateFunc: validation.StringIsValidRegExp,
 				Description:  "A regex string to filter results by certificate name.",
 			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The domain which the certificates serve, the wildcard certificates are matched as well.",
+			},
+			"expire_within_days": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only the certificates which expire within the days are retrieved, the expired certificates are included.",
+			},
 			"output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -65,6 +81,27 @@ func dataSourceKsyunCertificates() *schema.Resource {
 							Computed:    true,
 							Description: "name of the certificate.",
 						},
+						"not_after": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the certificate expires.",
+						},
+						"days_to_expiry": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The days left before the certificate expires, it's negative if the certificate has expired.",
+						},
+						"subject_common_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The common name of the subject of the certificate.",
+						},
+						"subject_alternative_names": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The subject alternative names of the certificate.",
+						},
 					},
 				},
 			},
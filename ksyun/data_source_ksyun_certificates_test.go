@@ -1,8 +1,10 @@
 package ksyun
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"testing"
+	"time"
 )
 
 func TestAccKsyunCertificatesDataSource_basic(t *testing.T) {
@@ -22,8 +24,45 @@ func TestAccKsyunCertificatesDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccKsyunCertificatesDataSource_expiring(t *testing.T) {
+	certPem, keyPem := testGenerateCertificate(t, "tf-acc-expiring.example.com", []string{"tf-acc-expiring.example.com"}, time.Now().AddDate(0, 0, 10))
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataCertificatesExpiringConfig, keyPem, certPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_certificates.foo"),
+					resource.TestCheckResourceAttr("data.ksyun_certificates.foo", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_certificates.foo", "certificates.0.subject_common_name", "tf-acc-expiring.example.com"),
+					resource.TestCheckResourceAttr("data.ksyun_certificates.foo", "certificates.0.days_to_expiry", "9"),
+				),
+			},
+		},
+	})
+}
+
 const testAccDataCertificatesConfig = `
 data "ksyun_certificates" "foo" {
  ids=[]
 }
 `
+
+const testAccDataCertificatesExpiringConfig = `
+resource "ksyun_certificate" "foo" {
+  certificate_name = "tf-acc-certificate-expiring"
+  private_key      = <<EOF
+%sEOF
+  public_key       = <<EOF
+%sEOF
+}
+
+data "ksyun_certificates" "foo" {
+  ids                = [ksyun_certificate.foo.id]
+  domain             = "tf-acc-expiring.example.com"
+  expire_within_days = 30
+}
+`
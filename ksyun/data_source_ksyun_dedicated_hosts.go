@@ -0,0 +1,168 @@
+/*
+This data source provides a list of dedicated hosts and their capacity.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_dedicated_hosts" "default" {
+	  output_file       = "output_result"
+	  availability_zone = ["cn-beijing-6a"]
+	  dedicated_type    = ["DC2"]
+	}
+
+	output "available_cpu" {
+	  value = data.ksyun_dedicated_hosts.default.dedicated_hosts[0].available_cpu
+	}
+
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunDedicatedHosts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunDedicatedHostsRead,
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of dedicated host IDs.",
+			},
+			"project_id": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "One or more project IDs.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of availability zones.",
+			},
+			"dedicated_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of dedicated host types.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by dedicated host name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of dedicated hosts that satisfy the condition.",
+			},
+			"dedicated_hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dedicated_host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the dedicated host.",
+						},
+						"dedicated_host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the dedicated host.",
+						},
+						"dedicated_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the dedicated host.",
+						},
+						"dedicated_cluster_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the dedicated cluster.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The availability zone of the dedicated host.",
+						},
+						"charge_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The charge type of the dedicated host.",
+						},
+						"auto_placement": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the instances without `dedicated_host_id` can be placed on the host automatically.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the dedicated host.",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vCPUs of the dedicated host.",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The memory size of the dedicated host, unit: GB.",
+						},
+						"available_cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vCPUs that can be allocated to instances.",
+						},
+						"available_memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The memory size that can be allocated to instances, unit: GB.",
+						},
+						"instance_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the instances on the dedicated host.",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The project ID of the dedicated host.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation time of the dedicated host.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunDedicatedHostsRead(d *schema.ResourceData, meta interface{}) error {
+	dedicatedHostService := DedicatedHostService{meta.(*KsyunClient)}
+	return dedicatedHostService.ReadAndSetDedicatedHosts(d, dataSourceKsyunDedicatedHosts())
+}
@@ -0,0 +1,34 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunDedicatedHostsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDedicatedHostsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_dedicated_hosts.foo"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataDedicatedHostsConfig = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_dedicated_hosts" "foo" {
+  output_file = "output_result"
+}
+`
@@ -0,0 +1,112 @@
+/*
+This data source provides a list of IAM policies, including the system policies and the custom policies.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_iam_policies" "default" {
+	  output_file = "output_result"
+	  policy_type = "system"
+	  name_regex  = "^IAMReadOnly"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunIamPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunIamPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "system", "custom"}, false),
+				Description:  "The type of the policies. Valid values: `all`, `system`, `custom`. Default is `all`.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by policy name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of policies that satisfy the condition.",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "a list of policies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy.",
+						},
+						"policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the policy.",
+						},
+						"policy_krn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The krn of the policy.",
+						},
+						"policy_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the policy, `system` or `custom`, which can be used by `ksyun_iam_relation_policy`.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the policy.",
+						},
+						"default_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The default version of the policy.",
+						},
+						"attachment_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the users, roles and groups which the policy is attached to.",
+						},
+						"create_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation date of the policy.",
+						},
+						"update_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The update date of the policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunIamPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyService := IamPolicyService{meta.(*KsyunClient)}
+	return iamPolicyService.ReadAndSetIamPolicies(d, dataSourceKsyunIamPolicies())
+}
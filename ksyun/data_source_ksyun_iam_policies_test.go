@@ -0,0 +1,35 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIAMPoliciesDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIAMPoliciesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_iam_policies.default"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policies.default", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policies.default", "policies.0.policy_type", "system"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policies.default", "policies.0.policy_krn", "krn:ksc:iam::ksc:policy/IAMReadOnlyAccess"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataIAMPoliciesConfig = `
+data "ksyun_iam_policies" "default" {
+  output_file = "output_result"
+  policy_type = "system"
+  name_regex  = "^IAMReadOnlyAccess$"
+}
+`
@@ -0,0 +1,238 @@
+/*
+This data source generates an IAM policy document in JSON format, which can be used by `ksyun_iam_policy`.
+The document is validated locally and rendered in a canonical form, so changing the order of the actions or the resources doesn't cause a diff.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_iam_policy_document" "base" {
+	  statement {
+	    sid       = "ReadBucket"
+	    actions   = ["ks3:ListBucket", "ks3:GetObject"]
+	    resources = ["krn:ksc:ks3:::tf-bucket", "krn:ksc:ks3:::tf-bucket/*"]
+	  }
+	}
+
+	data "ksyun_iam_policy_document" "default" {
+	  source_policy_documents = [data.ksyun_iam_policy_document.base.json]
+
+	  statement {
+	    sid       = "DenyOutsideOffice"
+	    effect    = "Deny"
+	    actions   = ["ks3:*"]
+	    resources = ["*"]
+	    condition {
+	      test     = "NotIpAddress"
+	      variable = "ksc:SourceIp"
+	      values   = ["10.0.0.0/8"]
+	    }
+	  }
+	}
+
+	resource "ksyun_iam_policy" "policy" {
+	  policy_name     = "tf-policy"
+	  policy_document = data.ksyun_iam_policy_document.default.json
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunIamPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunIamPolicyDocumentRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     iamPolicyDocumentVersion,
+				Description: "The version of the policy document. Default is `2015-11-01`.",
+			},
+			"source_policy_documents": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The policy documents whose statements are the base of this document. The statements of `statement` replace the ones with the same `sid`. The `NotAction` and `NotResource` of the statements are kept.",
+			},
+			"override_policy_documents": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The policy documents whose statements are merged at last, they replace the statements with the same `sid`, others are appended.",
+			},
+			"statement": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The statements of the policy document.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The id of the statement, which is used to merge the statements.",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Allow",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+							Description:  "Whether the statement allows or denies the actions. Valid values: `Allow`, `Deny`. Default is `Allow`.",
+						},
+						"actions": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The actions, such as `kec:DescribeInstances` or `ks3:*`.",
+						},
+						"resources": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The KRN of the resources, such as `krn:ksc:ks3:::bucket/*`, or `*`.",
+						},
+						"principals": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The principals of the statement, which are used by the trust policy of the role.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of the principal, such as `KSC` and `Service`.",
+									},
+									"identifiers": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "The identifiers of the principal.",
+									},
+								},
+							},
+						},
+						"condition": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The conditions of the statement.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"test": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The condition operator, such as `StringEquals` and `IpAddress`.",
+									},
+									"variable": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The condition key, such as `ksc:SourceIp`.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The values of the condition key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy document in JSON format.",
+			},
+		},
+	}
+}
+
+func dataSourceKsyunIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := &iamPolicyDocument{
+		Version: d.Get("version").(string),
+	}
+	for i, v := range d.Get("source_policy_documents").([]interface{}) {
+		source, err := parseIamPolicyDocument(fmt.Sprintf("%v", v))
+		if err != nil {
+			return fmt.Errorf("source_policy_documents.%d: %s", i, err)
+		}
+		doc.merge(source.Statement)
+	}
+	doc.merge(expandIamPolicyStatements(d.Get("statement").([]interface{})))
+	for i, v := range d.Get("override_policy_documents").([]interface{}) {
+		override, err := parseIamPolicyDocument(fmt.Sprintf("%v", v))
+		if err != nil {
+			return fmt.Errorf("override_policy_documents.%d: %s", i, err)
+		}
+		doc.merge(override.Statement)
+	}
+
+	doc.canonicalize()
+	if err := doc.validate(); err != nil {
+		return err
+	}
+	document, err := doc.String()
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%d", hashcode.String(document)))
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		if err = writeToFile(outputFile.(string), doc); err != nil {
+			return err
+		}
+	}
+	return d.Set("json", document)
+}
+
+func expandIamPolicyStatements(statements []interface{}) (result []*iamPolicyStatement) {
+	for _, v := range statements {
+		m := v.(map[string]interface{})
+		st := &iamPolicyStatement{
+			Sid:      m["sid"].(string),
+			Effect:   m["effect"].(string),
+			Action:   SchemaSetToStringSlice(m["actions"]),
+			Resource: SchemaSetToStringSlice(m["resources"]),
+		}
+		for _, p := range m["principals"].(*schema.Set).List() {
+			principal := p.(map[string]interface{})
+			if st.Principal == nil {
+				st.Principal = map[string]iamPolicyStringList{}
+			}
+			principalType := principal["type"].(string)
+			st.Principal[principalType] = append(st.Principal[principalType], SchemaSetToStringSlice(principal["identifiers"])...)
+		}
+		for _, c := range m["condition"].(*schema.Set).List() {
+			condition := c.(map[string]interface{})
+			if st.Condition == nil {
+				st.Condition = map[string]map[string]iamPolicyStringList{}
+			}
+			test := condition["test"].(string)
+			if st.Condition[test] == nil {
+				st.Condition[test] = map[string]iamPolicyStringList{}
+			}
+			variable := condition["variable"].(string)
+			for _, value := range condition["values"].([]interface{}) {
+				st.Condition[test][variable] = append(st.Condition[test][variable], fmt.Sprintf("%v", value))
+			}
+		}
+		result = append(result, st)
+	}
+	return result
+}
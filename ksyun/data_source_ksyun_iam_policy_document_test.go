@@ -0,0 +1,128 @@
+package ksyun
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIAMPolicyDocumentDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIAMPolicyDocumentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_iam_policy_document.default"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_document.default", "json", testAccDataIAMPolicyDocumentJson),
+				),
+			},
+			{
+				Config:      testAccDataIAMPolicyDocumentInvalidConfig,
+				ExpectError: regexp.MustCompile("must be `\\*` or like"),
+			},
+		},
+	})
+}
+
+const testAccDataIAMPolicyDocumentConfig = `
+provider "ksyun" {
+  region     = "cn-beijing-6"
+  access_key = "ak"
+  secret_key = "sk"
+}
+
+data "ksyun_iam_policy_document" "base" {
+  statement {
+    sid       = "ReadBucket"
+    actions   = ["ks3:ListBucket", "ks3:GetObject"]
+    resources = ["krn:ksc:ks3:::tf-bucket/*", "krn:ksc:ks3:::tf-bucket"]
+  }
+  statement {
+    sid       = "Describe"
+    actions   = ["kec:DescribeInstances"]
+    resources = ["*"]
+  }
+}
+
+data "ksyun_iam_policy_document" "default" {
+  source_policy_documents = [data.ksyun_iam_policy_document.base.json]
+
+  statement {
+    sid       = "Describe"
+    actions   = ["kec:Describe*"]
+    resources = ["*"]
+  }
+
+  statement {
+    effect    = "Deny"
+    actions   = ["ks3:*"]
+    resources = ["*"]
+    condition {
+      test     = "NotIpAddress"
+      variable = "ksc:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+}
+`
+
+const testAccDataIAMPolicyDocumentJson = `{
+  "Version": "2015-11-01",
+  "Statement": [
+    {
+      "Sid": "ReadBucket",
+      "Effect": "Allow",
+      "Action": [
+        "ks3:GetObject",
+        "ks3:ListBucket"
+      ],
+      "Resource": [
+        "krn:ksc:ks3:::tf-bucket",
+        "krn:ksc:ks3:::tf-bucket/*"
+      ]
+    },
+    {
+      "Sid": "Describe",
+      "Effect": "Allow",
+      "Action": [
+        "kec:Describe*"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Deny",
+      "Action": [
+        "ks3:*"
+      ],
+      "Resource": [
+        "*"
+      ],
+      "Condition": {
+        "NotIpAddress": {
+          "ksc:SourceIp": [
+            "10.0.0.0/8"
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+const testAccDataIAMPolicyDocumentInvalidConfig = `
+provider "ksyun" {
+  region     = "cn-beijing-6"
+  access_key = "ak"
+  secret_key = "sk"
+}
+
+data "ksyun_iam_policy_document" "default" {
+  statement {
+    actions   = ["ks3:GetObject"]
+    resources = ["arn:aws:s3:::tf-bucket/*"]
+  }
+}
+`
@@ -0,0 +1,233 @@
+/*
+This data source evaluates IAM policy documents against the requests locally, without calling any API.
+It returns whether each request is allowed and which statement decides it, so the modules can assert least privilege at plan time.
+
+An explicit `Deny` always wins, otherwise the request is allowed when any statement allows it, and it's denied implicitly when no statement matches.
+The documents are validated in the same way as `ksyun_iam_policy_document`, and every statement must set `Resource` or `NotResource`, an invalid document fails the data source instead of giving a decision.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_iam_policy_document" "default" {
+	  statement {
+	    sid       = "ReadBucket"
+	    actions   = ["ks3:Get*", "ks3:List*"]
+	    resources = ["krn:ksc:ks3:::tf-bucket/*"]
+	  }
+	  statement {
+	    sid       = "DenyOutsideOffice"
+	    effect    = "Deny"
+	    actions   = ["ks3:*"]
+	    resources = ["*"]
+	    condition {
+	      test     = "NotIpAddress"
+	      variable = "ksc:SourceIp"
+	      values   = ["10.0.0.0/8"]
+	    }
+	  }
+	}
+
+	data "ksyun_iam_policy_simulation" "default" {
+	  policy_documents = [data.ksyun_iam_policy_document.default.json]
+
+	  request {
+	    action   = "ks3:GetObject"
+	    resource = "krn:ksc:ks3:::tf-bucket/index.html"
+	    context {
+	      key    = "ksc:SourceIp"
+	      values = ["10.1.1.1"]
+	    }
+	  }
+
+	  request {
+	    action   = "ks3:PutObject"
+	    resource = "krn:ksc:ks3:::tf-bucket/index.html"
+	    context {
+	      key    = "ksc:SourceIp"
+	      values = ["10.1.1.1"]
+	    }
+	  }
+	}
+
+	output "least_privilege" {
+	  value = data.ksyun_iam_policy_simulation.default.results.*.decision
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKsyunIamPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunIamPolicySimulationRead,
+		Schema: map[string]*schema.Schema{
+			"policy_documents": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The policy documents in JSON format, such as the `policy_document` of `ksyun_iam_policy` or the `json` of `ksyun_iam_policy_document`.",
+			},
+			"request": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The requests to evaluate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The action of the request, such as `kec:DescribeInstances`.",
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: "The KRN of the resource of the request. Default is `*`.",
+						},
+						"context": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The condition keys of the request.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The condition key, such as `ksc:SourceIp`.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The values of the condition key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the requests are allowed.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The results of the requests, in the same order as `request`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action of the request.",
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource of the request.",
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The decision of the request. Values: `allowed`, `explicitDeny`, `implicitDeny`.",
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the request is allowed.",
+						},
+						"matched_policy_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The index in `policy_documents` of the document which decides the request, `-1` if the request is denied implicitly.",
+						},
+						"matched_statement": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The sid of the statement which decides the request, or `Statement.<index>` if the statement has no sid.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunIamPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		docs       []*iamPolicyDocument
+		results    []map[string]interface{}
+		allAllowed = true
+		ids        []string
+	)
+	for i, v := range d.Get("policy_documents").([]interface{}) {
+		doc, err := parseIamPolicyDocument(fmt.Sprintf("%v", v))
+		if err != nil {
+			return fmt.Errorf("policy_documents.%d: %s", i, err)
+		}
+		if err = doc.validate(); err != nil {
+			return fmt.Errorf("policy_documents.%d: %s", i, err)
+		}
+		docs = append(docs, doc)
+		ids = append(ids, fmt.Sprintf("%v", v))
+	}
+
+	for i, v := range d.Get("request").([]interface{}) {
+		m := v.(map[string]interface{})
+		request := iamSimulationRequest{
+			Action:   m["action"].(string),
+			Resource: m["resource"].(string),
+			Context:  map[string][]string{},
+		}
+		for _, c := range m["context"].(*schema.Set).List() {
+			context := c.(map[string]interface{})
+			key := context["key"].(string)
+			for _, value := range context["values"].([]interface{}) {
+				request.Context[key] = append(request.Context[key], fmt.Sprintf("%v", value))
+			}
+		}
+		result, err := simulateIamPolicies(docs, request)
+		if err != nil {
+			return fmt.Errorf("request.%d: %s", i, err)
+		}
+		allowed := result.Decision == iamDecisionAllowed
+		allAllowed = allAllowed && allowed
+		results = append(results, map[string]interface{}{
+			"action":               request.Action,
+			"resource":             request.Resource,
+			"decision":             result.Decision,
+			"allowed":              allowed,
+			"matched_policy_index": result.MatchedPolicy,
+			"matched_statement":    result.MatchedStatement,
+		})
+		ids = append(ids, fmt.Sprintf("%s:%s:%s", request.Action, request.Resource, result.Decision))
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(ids, "\n"))))
+	if err := d.Set("results", results); err != nil {
+		return err
+	}
+	if err := d.Set("all_allowed", allAllowed); err != nil {
+		return err
+	}
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		return writeToFile(outputFile.(string), results)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package ksyun
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIAMPolicySimulationDataSource_basic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIAMPolicySimulationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_iam_policy_simulation.default"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "all_allowed", "false"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.#", "3"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.0.matched_statement", "ReadBucket"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.1.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.1.matched_policy_index", "-1"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.2.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_simulation.default", "results.2.matched_policy_index", "1"),
+				),
+			},
+			{
+				Config:      testAccDataIAMPolicySimulationInvalidConfig,
+				ExpectError: regexp.MustCompile("policy document is not valid"),
+			},
+		},
+	})
+}
+
+const testAccDataIAMPolicySimulationConfig = `
+provider "ksyun" {
+  region     = "cn-beijing-6"
+  access_key = "ak"
+  secret_key = "sk"
+}
+
+data "ksyun_iam_policy_document" "default" {
+  statement {
+    sid       = "ReadBucket"
+    actions   = ["ks3:Get*", "ks3:List*"]
+    resources = ["krn:ksc:ks3:::tf-bucket/*"]
+  }
+}
+
+data "ksyun_iam_policy_simulation" "default" {
+  policy_documents = [
+    data.ksyun_iam_policy_document.default.json,
+    jsonencode({
+      Version = "2015-11-01"
+      Statement = [{
+        Sid       = "DenyOutsideOffice"
+        Effect    = "Deny"
+        Action    = "ks3:*"
+        Resource  = "*"
+        Condition = { NotIpAddress = { "ksc:SourceIp" = "10.0.0.0/8" } }
+      }]
+    }),
+  ]
+
+  request {
+    action   = "ks3:GetObject"
+    resource = "krn:ksc:ks3:::tf-bucket/index.html"
+    context {
+      key    = "ksc:SourceIp"
+      values = ["10.1.1.1"]
+    }
+  }
+
+  request {
+    action   = "ks3:PutObject"
+    resource = "krn:ksc:ks3:::tf-bucket/index.html"
+    context {
+      key    = "ksc:SourceIp"
+      values = ["10.1.1.1"]
+    }
+  }
+
+  request {
+    action   = "ks3:GetObject"
+    resource = "krn:ksc:ks3:::tf-bucket/index.html"
+    context {
+      key    = "ksc:SourceIp"
+      values = ["192.168.1.1"]
+    }
+  }
+}
+`
+
+const testAccDataIAMPolicySimulationInvalidConfig = `
+provider "ksyun" {
+  region     = "cn-beijing-6"
+  access_key = "ak"
+  secret_key = "sk"
+}
+
+data "ksyun_iam_policy_simulation" "default" {
+  policy_documents = ["{\"Statement\": \"oops\"}"]
+
+  request {
+    action = "kec:DescribeInstances"
+  }
+}
+`
@@ -0,0 +1,110 @@
+/*
+This data source provides a list of IAM projects.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_iam_projects" "default" {
+	  output_file = "output_result"
+	  name_regex  = "^tf-project$"
+	}
+
+	data "ksyun_lines" "default" {
+	  line_name = "BGP"
+	}
+
+	resource "ksyun_eip" "default" {
+	  line_id       = data.ksyun_lines.default.lines.0.line_id
+	  band_width    = 1
+	  charge_type   = "PostPaidByPeak"
+	  purchase_time = 1
+	  project_id    = data.ksyun_iam_projects.default.projects.0.project_id
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunIamProjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunIamProjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of project IDs.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by project name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of projects that satisfy the condition.",
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "a list of projects.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the project.",
+						},
+						"project_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the project.",
+						},
+						"project_desc": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the project.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the project.",
+						},
+						"krn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The krn of the project.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation time of the project.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunIamProjectsRead(d *schema.ResourceData, meta interface{}) error {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	return iamProjectService.ReadAndSetIamProjects(d, dataSourceKsyunIamProjects())
+}
@@ -0,0 +1,37 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIAMProjectsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIAMProjectsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_iam_projects.default"),
+					resource.TestCheckResourceAttr("data.ksyun_iam_projects.default", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ksyun_iam_projects.default", "projects.0.project_id", "ksyun_iam_project.project", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataIAMProjectsConfig = `
+resource "ksyun_iam_project" "project" {
+  project_name = "tf-acc-projects"
+}
+
+data "ksyun_iam_projects" "default" {
+  output_file = "output_result"
+  ids         = [ksyun_iam_project.project.id]
+}
+`
@@ -0,0 +1,163 @@
+/*
+This data source provides a list of KEC instance types and the availability zones where they are on sale.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_instance_types" "default" {
+	  output_file       = "output_result"
+	  availability_zone = "cn-beijing-6a"
+	  cpu               = 2
+	  memory            = 4
+	}
+
+	resource "ksyun_instance" "foo" {
+	  instance_type = data.ksyun_instance_types.default.instance_types[0].instance_type
+	  ...
+	}
+
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKsyunInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunInstanceTypesRead,
+		Schema: map[string]*schema.Schema{
+			"instance_family": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of instance families, such as `N3`, `S6`.",
+			},
+			"instance_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of instance types.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the instance types on sale in this availability zone are returned.",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of vCPUs of the instance type.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The memory size of the instance type, unit: GB.",
+			},
+			"gpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of GPUs of the instance type.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of instance types that satisfy the condition.",
+			},
+			"instance_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The instance type.",
+						},
+						"instance_family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The instance family.",
+						},
+						"instance_family_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the instance family.",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vCPUs.",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The memory size, unit: GB.",
+						},
+						"gpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of GPUs.",
+						},
+						"network_interface_quota": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The max number of network interfaces.",
+						},
+						"private_ip_quota": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The max number of private IPs of a network interface.",
+						},
+						"data_disk_quota_set": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The limits of the data disks.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_disk_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the data disk.",
+									},
+									"data_disk_min_size": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The min size of the data disk.",
+									},
+									"data_disk_max_size": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The max size of the data disk.",
+									},
+								},
+							},
+						},
+						"availability_zones": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The availability zones where the instance type is on sale.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
+	kecService := KecService{meta.(*KsyunClient)}
+	return kecService.ReadAndSetKecInstanceTypes(d, dataSourceKsyunInstanceTypes())
+}
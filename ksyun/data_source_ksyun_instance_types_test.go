@@ -0,0 +1,36 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunInstanceTypesDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataInstanceTypesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_instance_types.foo"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataInstanceTypesConfig = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_instance_types" "foo" {
+  output_file     = "output_result"
+  instance_family = ["N3"]
+  cpu             = 2
+}
+`
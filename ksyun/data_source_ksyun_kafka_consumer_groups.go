@@ -0,0 +1,78 @@
+/*
+This data source provides a list of consumer groups of the Kafka instance.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_kafka_consumer_groups" "default" {
+	  output_file = "output_result"
+	  instance_id = ksyun_kafka_instance.default.id
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunKafkaConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKafkaConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The id of the kafka instance.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by group name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of consumer groups that satisfy the condition.",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the consumer group.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the consumer group.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKafkaConsumerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	return kafkaService.ReadAndSetKafkaConsumerGroups(d, dataSourceKsyunKafkaConsumerGroups())
+}
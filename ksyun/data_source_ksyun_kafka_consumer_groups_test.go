@@ -0,0 +1,38 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKafkaConsumerGroupsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKafkaConsumerGroupsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_kafka_consumer_groups.default"),
+					resource.TestCheckResourceAttr("data.ksyun_kafka_consumer_groups.default", "consumer_groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataKafkaConsumerGroupsConfig = testAccKafkaBase + `
+resource "ksyun_kafka_consumer_group" "default" {
+  instance_id = ksyun_kafka_instance.default.id
+  group_name  = "tf-acc-orders-billing"
+}
+
+data "ksyun_kafka_consumer_groups" "default" {
+  output_file = "output_result"
+  instance_id = ksyun_kafka_consumer_group.default.instance_id
+  name_regex  = "^tf-acc-orders-billing$"
+}
+`
@@ -0,0 +1,158 @@
+/*
+This data source provides a list of Kafka instances.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_kafka_instances" "default" {
+	  output_file = "output_result"
+	  ids         = []
+	  name_regex  = "tf-kafka"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunKafkaInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKafkaInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of Kafka instance IDs, all the Kafka instances belong to this region will be retrieved if the ID is `\"\"`.",
+			},
+			"project_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "One or more project IDs.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The id of VPC linked to the instances.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by instance name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of Kafka instances that satisfy the condition.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the instance.",
+						},
+						"engine_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the kafka engine.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The spec of the broker cpu and memory.",
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The storage of the instance, measured in GB.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The availability zones of the brokers.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of VPC linked to the instance.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of subnet linked to the instance.",
+						},
+						"vip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The vip of the instance.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The port of the instance.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the instance.",
+						},
+						"bill_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The charge type of the instance.",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The project id of the instance.",
+						},
+						"create_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation date of the instance.",
+						},
+						"expiration_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expiration date of the instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKafkaInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	return kafkaService.ReadAndSetKafkaInstances(d, dataSourceKsyunKafkaInstances())
+}
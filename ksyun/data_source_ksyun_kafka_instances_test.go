@@ -0,0 +1,33 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKafkaInstancesDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKafkaInstancesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_kafka_instances.default"),
+					resource.TestCheckResourceAttr("data.ksyun_kafka_instances.default", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_kafka_instances.default", "instances.0.availability_zone.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataKafkaInstancesConfig = testAccKafkaBase + `
+data "ksyun_kafka_instances" "default" {
+  output_file = "output_result"
+  ids         = [ksyun_kafka_instance.default.id]
+}
+`
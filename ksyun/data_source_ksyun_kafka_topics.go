@@ -0,0 +1,95 @@
+/*
+This data source provides a list of topics of the Kafka instance.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_kafka_topics" "default" {
+	  output_file = "output_result"
+	  instance_id = ksyun_kafka_instance.default.id
+	  name_regex  = "^orders"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunKafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKafkaTopicsRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The id of the kafka instance.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by topic name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of topics that satisfy the condition.",
+			},
+			"topics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the topic.",
+						},
+						"partition_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the partitions.",
+						},
+						"replica_num": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the replicas of each partition.",
+						},
+						"retention_ms": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The retention time of the messages, measured in milliseconds.",
+						},
+						"configs": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The topic level configs.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation time of the topic.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKafkaTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	return kafkaService.ReadAndSetKafkaTopics(d, dataSourceKsyunKafkaTopics())
+}
@@ -0,0 +1,41 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKafkaTopicsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKafkaTopicsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_kafka_topics.default"),
+					resource.TestCheckResourceAttr("data.ksyun_kafka_topics.default", "topics.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_kafka_topics.default", "topics.0.partition_num", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataKafkaTopicsConfig = testAccKafkaBase + `
+resource "ksyun_kafka_topic" "default" {
+  instance_id   = ksyun_kafka_instance.default.id
+  topic_name    = "tf-acc-orders"
+  partition_num = 3
+  replica_num   = 3
+}
+
+data "ksyun_kafka_topics" "default" {
+  output_file = "output_result"
+  instance_id = ksyun_kafka_topic.default.instance_id
+  name_regex  = "^tf-acc-orders$"
+}
+`
@@ -0,0 +1,83 @@
+/*
+This data source encrypts the plaintext with a KMS key, so the ciphertext can be stored in the configuration
+and decrypted by the data source `ksyun_kms_secret`.
+
+The ciphertext changes every time it's read, use the output to build the configuration once instead of referencing it in the resources.
+
+# Example Usage
+
+```hcl
+
+resource "ksyun_kms_key" "default" {
+  alias = "tf-key"
+}
+
+data "ksyun_kms_ciphertext" "default" {
+  key_id    = ksyun_kms_key.default.id
+  plaintext = "Password@123"
+}
+
+output "ciphertext" {
+  value = data.ksyun_kms_ciphertext.default.ciphertext_blob
+}
+
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKsyunKmsCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKmsCiphertextRead,
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The id of the KMS key.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The plaintext to encrypt.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"ciphertext_blob": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ciphertext in base64.",
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	kmsService := KmsService{meta.(*KsyunClient)}
+	keyId := d.Get("key_id").(string)
+	ciphertext, err := kmsService.KmsEncrypt(keyId, d.Get("plaintext").(string))
+	if err != nil {
+		return fmt.Errorf("error on encrypting with kms key %q, %s", keyId, err)
+	}
+	d.SetId(fmt.Sprintf("%d", hashcode.String(ciphertext)))
+	if err = d.Set("ciphertext_blob", ciphertext); err != nil {
+		return err
+	}
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		return writeToFile(outputFile.(string), map[string]interface{}{
+			"key_id":          keyId,
+			"ciphertext_blob": ciphertext,
+		})
+	}
+	return nil
+}
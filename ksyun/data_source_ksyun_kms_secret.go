@@ -0,0 +1,102 @@
+/*
+This data source decrypts the ciphertexts encrypted by KMS at plan time, so the secrets such as `master_user_password` of `ksyun_krds`,
+`instance_password` of `ksyun_instance` and `pre_shared_key` of `ksyun_vpn_tunnel` don't have to be stored in plaintext.
+
+The plaintexts are stored in the state, please protect the state as well.
+
+# Example Usage
+
+```hcl
+
+data "ksyun_kms_secret" "default" {
+  secret {
+    name    = "db_password"
+    payload = "AQICAHh..."
+  }
+}
+
+resource "ksyun_krds" "default" {
+  # ...
+  master_user_password = data.ksyun_kms_secret.default.plaintext["db_password"]
+}
+
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKsyunKmsSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKmsSecretRead,
+		Schema: map[string]*schema.Schema{
+			"secret": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The secrets to decrypt.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the secret, which is the key in `plaintext`.",
+						},
+						"payload": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ciphertext in base64, such as the `ciphertext_blob` of `ksyun_kms_ciphertext`.",
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`). Only the names of the secrets are saved.",
+			},
+			"plaintext": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The map of the secret names to the plaintexts.",
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKmsSecretRead(d *schema.ResourceData, meta interface{}) error {
+	kmsService := KmsService{meta.(*KsyunClient)}
+	plaintext := map[string]interface{}{}
+	var names []string
+	for _, v := range d.Get("secret").(*schema.Set).List() {
+		secret := v.(map[string]interface{})
+		name := secret["name"].(string)
+		if _, ok := plaintext[name]; ok {
+			return fmt.Errorf("secret %q is duplicated", name)
+		}
+		value, err := kmsService.KmsDecrypt(secret["payload"].(string))
+		if err != nil {
+			return fmt.Errorf("error on decrypting secret %q, %s", name, err)
+		}
+		plaintext[name] = value
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	if err := d.Set("plaintext", plaintext); err != nil {
+		return err
+	}
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		return writeToFile(outputFile.(string), names)
+	}
+	return nil
+}
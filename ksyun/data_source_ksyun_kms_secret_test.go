@@ -0,0 +1,47 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKmsSecretDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKmsSecretConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_kms_ciphertext.default"),
+					resource.TestCheckResourceAttrSet("data.ksyun_kms_ciphertext.default", "ciphertext_blob"),
+					testAccCheckIDExists("data.ksyun_kms_secret.default"),
+					resource.TestCheckResourceAttr("data.ksyun_kms_secret.default", "plaintext.password", "Password@123"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataKmsSecretConfig = `
+resource "ksyun_kms_key" "default" {
+  alias = "tf-acc-secret-key"
+}
+
+data "ksyun_kms_ciphertext" "default" {
+  key_id      = ksyun_kms_key.default.id
+  plaintext   = "Password@123"
+  output_file = "output_result"
+}
+
+data "ksyun_kms_secret" "default" {
+  secret {
+    name    = "password"
+    payload = data.ksyun_kms_ciphertext.default.ciphertext_blob
+  }
+  output_file = "output_result"
+}
+`
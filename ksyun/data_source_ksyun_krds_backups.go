@@ -0,0 +1,126 @@
+/*
+This data source provides a list of backups of the KRDS instance.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_krds_backups" "default" {
+	  output_file            = "output_result"
+	  db_instance_identifier = "67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx"
+	  backup_type            = "MANUAL_BACKUP"
+	}
+
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunKrdsBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKrdsBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the KRDS instance.",
+			},
+			"ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of backup IDs.",
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AUTO_BACKUP",
+					"MANUAL_BACKUP",
+				}, false),
+				Description: "The type of the backup. Valid values: `AUTO_BACKUP`, `MANUAL_BACKUP`.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				Description:  "A regex string to filter results by backup name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of backups that satisfy the condition.",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the backup.",
+						},
+						"backup_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the backup.",
+						},
+						"db_instance_identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the KRDS instance.",
+						},
+						"backup_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the backup.",
+						},
+						"backup_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The mode of the backup.",
+						},
+						"backup_size": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The size of the backup, unit: MB.",
+						},
+						"backup_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the backup.",
+						},
+						"backup_create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the backup started.",
+						},
+						"backup_updated_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the backup finished.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKrdsBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	return krdsService.ReadAndSetKrdsBackups(d, dataSourceKsyunKrdsBackups())
+}
@@ -0,0 +1,38 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKrdsBackupsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKrdsBackupsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_krds_backups.foo"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_backups.foo", "total_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataKrdsBackupsConfig = testAccKrdsAccountBase + `
+resource "ksyun_krds_backup" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  backup_name            = "tf-acc-krds-backups"
+}
+
+data "ksyun_krds_backups" "foo" {
+  output_file            = "output_result"
+  db_instance_identifier = ksyun_krds.default.id
+  ids                    = [ksyun_krds_backup.foo.id]
+}
+`
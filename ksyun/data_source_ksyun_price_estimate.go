@@ -0,0 +1,183 @@
+/*
+This data source provides the estimated price of an instance, a volume or an EIP before it is created.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_price_estimate" "kec" {
+	  product_type      = "kec"
+	  charge_type       = "HourlyInstantSettlement"
+	  availability_zone = "cn-beijing-6a"
+	  instance_type     = "N3.2B"
+	  system_disk {
+	    disk_type = "SSD3.0"
+	    disk_size = 40
+	  }
+	  data_disks {
+	    disk_type = "SSD3.0"
+	    disk_size = 100
+	  }
+	}
+
+	data "ksyun_price_estimate" "eip" {
+	  product_type  = "eip"
+	  charge_type   = "PrePaidByMonth"
+	  purchase_time = 3
+	  band_width    = 5
+	  line_id       = "cf4e1df7-6a80-4e94-b8c6-xxxxxxxxxxxx"
+	}
+
+	output "kec_monthly_price" {
+	  value = data.ksyun_price_estimate.kec.monthly_price
+	}
+
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceKsyunPriceEstimate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunPriceEstimateRead,
+		Schema: map[string]*schema.Schema{
+			"product_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"kec",
+					"ebs",
+					"eip",
+				}, false),
+				Description: "The product to estimate. Valid values: `kec`, `ebs`, `eip`.",
+			},
+			"charge_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The charge type of the product, such as `HourlyInstantSettlement`, `Daily`, `PrePaidByMonth`. It's the same as the `charge_type` of the resource.",
+			},
+			"purchase_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 36),
+				Description:  "The purchase time of the prepaid product, unit: month.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The availability zone of the instance or the volume.",
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the instance. Required when `product_type` is `kec`.",
+			},
+			"system_disk": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The system disk of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the system disk.",
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The size of the system disk.",
+						},
+					},
+				},
+			},
+			"data_disks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    8,
+				Description: "The data disks of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the data disk.",
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The size of the data disk.",
+						},
+					},
+				},
+			},
+			"volume_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the volume. Required when `product_type` is `ebs`.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The size of the volume, unit: GB. Required when `product_type` is `ebs`.",
+			},
+			"band_width": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The band width of the EIP, unit: Mbps. Required when `product_type` is `eip`.",
+			},
+			"line_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The line ID of the EIP.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The original price of the charge unit.",
+			},
+			"discount_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The discount price of the charge unit.",
+			},
+			"price_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The charge unit of the price, `Hour`, `Day` or `Month`.",
+			},
+			"currency": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency of the price.",
+			},
+			"hourly_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The hourly price after discount.",
+			},
+			"monthly_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The monthly price after discount, a month is counted as 30 days.",
+			},
+		},
+	}
+}
+
+func dataSourceKsyunPriceEstimateRead(d *schema.ResourceData, meta interface{}) error {
+	tradeService := TradeService{meta.(*KsyunClient)}
+	return tradeService.ReadAndSetPriceEstimate(d, dataSourceKsyunPriceEstimate())
+}
@@ -0,0 +1,70 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunPriceEstimateDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataPriceEstimateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_price_estimate.foo"),
+					resource.TestCheckResourceAttrSet("data.ksyun_price_estimate.foo", "monthly_price"),
+				),
+			},
+		},
+	})
+}
+
+func TestPriceEstimateHourlyAndMonthly(t *testing.T) {
+	cases := []struct {
+		price        float64
+		unit         string
+		purchaseTime int
+		hourly       float64
+		monthly      float64
+	}{
+		{0.5, "Hour", 1, 0.5, 360},
+		{12, "Day", 1, 0.5, 360},
+		{720, "Month", 2, 0.5, 360},
+		{100, "month", 0, 0.1389, 100},
+	}
+	for _, c := range cases {
+		hourly, monthly, err := priceEstimateHourlyAndMonthly(c.price, c.unit, c.purchaseTime)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if hourly != c.hourly || monthly != c.monthly {
+			t.Errorf("%v %s %d: expected %v/%v, got %v/%v", c.price, c.unit, c.purchaseTime, c.hourly, c.monthly, hourly, monthly)
+		}
+	}
+	if _, _, err := priceEstimateHourlyAndMonthly(1, "Year", 1); err == nil {
+		t.Errorf("expected error for unsupported unit")
+	}
+}
+
+const testAccDataPriceEstimateConfig = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_price_estimate" "foo" {
+  output_file       = "output_result"
+  product_type      = "kec"
+  charge_type       = "HourlyInstantSettlement"
+  availability_zone = "cn-beijing-6a"
+  instance_type     = "N3.2B"
+  system_disk {
+    disk_type = "SSD3.0"
+    disk_size = 40
+  }
+}
+`
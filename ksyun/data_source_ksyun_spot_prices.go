@@ -0,0 +1,92 @@
+/*
+This data source provides a list of the current prices of KEC spot instances.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_spot_prices" "default" {
+	  output_file        = "output_result"
+	  instance_types     = ["N3.2B", "N3.4B"]
+	  availability_zones = ["cn-beijing-6a"]
+	}
+
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKsyunSpotPrices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunSpotPricesRead,
+		Schema: map[string]*schema.Schema{
+			"instance_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of instance types.",
+			},
+			"availability_zones": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "A list of availability zones.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of spot prices that satisfy the condition.",
+			},
+			"spot_prices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of instance.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The availability zone.",
+						},
+						"spot_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The current hourly price of the spot instance.",
+						},
+						"on_demand_price": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The hourly price of the on-demand instance.",
+						},
+						"timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the price takes effect.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunSpotPricesRead(d *schema.ResourceData, meta interface{}) error {
+	kecService := KecService{meta.(*KsyunClient)}
+	return kecService.ReadAndSetKecSpotPrices(d, dataSourceKsyunSpotPrices())
+}
@@ -0,0 +1,35 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunSpotPricesDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSpotPricesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_spot_prices.foo"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSpotPricesConfig = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_spot_prices" "foo" {
+  output_file    = "output_result"
+  instance_types = ["N3.2B"]
+}
+`
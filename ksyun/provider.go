@@ -162,6 +162,10 @@ Instance(KEC)
 		ksyun_auto_snapshot_policy
 		ksyun_auto_snapshot_volume_association
 		ksyun_data_guard_group
+		ksyun_spot_prices
+		ksyun_dedicated_hosts
+		ksyun_instance_types
+		ksyun_price_estimate
 
 	Resource
 		ksyun_instance
@@ -169,6 +173,12 @@ Instance(KEC)
 		ksyun_auto_snapshot_policy
 		ksyun_auto_snapshot_volume_association
 		ksyun_data_guard_group
+		ksyun_kec_launch_template
+		ksyun_kec_launch_template_version
+		ksyun_dedicated_host
+		ksyun_image
+		ksyun_image_copy
+		ksyun_image_share_permission
 
 Volume(EBS)
 
@@ -225,6 +235,25 @@ KCM
 
 	Resource
 		ksyun_certificate
+		ksyun_ssl_certificate_order
+		ksyun_ssl_certificate_order_validation
+
+KMS
+
+	Data Source
+		ksyun_kms_ciphertext
+		ksyun_kms_secret
+
+	Resource
+		ksyun_kms_key
+
+Audit
+
+	Data Source
+		ksyun_audit_events
+
+	Resource
+		ksyun_audit_trail
 
 KRDS
 
@@ -232,6 +261,7 @@ KRDS
 		ksyun_krds
 		ksyun_krds_security_groups
 		ksyun_krds_parameter_group
+		ksyun_krds_backups
 
 	Resource
 		ksyun_krds
@@ -239,12 +269,22 @@ KRDS
 		ksyun_krds_security_group
 		ksyun_krds_security_group_rule
 		ksyun_krds_parameter_group
+		ksyun_krds_database
+		ksyun_krds_account
+		ksyun_krds_backup
+		ksyun_krds_backup_policy
+		ksyun_krds_proxy
 
 SQLServer
 
 	Data Source
 		ksyun_sqlservers
 
+	Resource
+		ksyun_sqlserver
+		ksyun_sqlserver_rr
+		ksyun_sqlserver_backup
+
 MongoDB
 
 	Data Source
@@ -252,6 +292,10 @@ MongoDB
 
 	Resource
 		ksyun_mongodb_instance
+		ksyun_mongodb_shard_instance
+		ksyun_mongodb_backup
+		ksyun_mongodb_backup_policy
+		ksyun_mongodb_user
 
 RabbitMQ
 
@@ -261,6 +305,25 @@ RabbitMQ
 	Resource
 		ksyun_rabbitmq_instance
 		ksyun_rabbitmq_security_rule
+		ksyun_rabbitmq_vhost
+		ksyun_rabbitmq_user
+		ksyun_rabbitmq_permission
+		ksyun_rabbitmq_exchange
+		ksyun_rabbitmq_queue
+		ksyun_rabbitmq_binding
+
+Kafka
+
+	Data Source
+		ksyun_kafka_instances
+		ksyun_kafka_topics
+		ksyun_kafka_consumer_groups
+
+	Resource
+		ksyun_kafka_instance
+		ksyun_kafka_topic
+		ksyun_kafka_consumer_group
+		ksyun_kafka_acl
 
 Redis
 
@@ -272,6 +335,9 @@ Redis
 		ksyun_redis_instance
 		ksyun_redis_instance_node
 		ksyun_redis_sec_group
+		ksyun_redis_backup
+		ksyun_redis_parameter_group
+		ksyun_redis_account
 
 Auto Scaling
 
@@ -325,6 +391,10 @@ IAM
 		ksyun_iam_users
 		ksyun_iam_roles
 		ksyun_iam_groups
+		ksyun_iam_projects
+		ksyun_iam_policy_document
+		ksyun_iam_policies
+		ksyun_iam_policy_simulation
 
 	Resource
 		ksyun_iam_user
@@ -332,6 +402,12 @@ IAM
 		ksyun_iam_group
 		ksyun_iam_policy
 		ksyun_iam_relation_policy
+		ksyun_iam_project
+		ksyun_iam_project_member
+		ksyun_iam_group_membership
+		ksyun_iam_access_key
+		ksyun_iam_instance_profile
+		ksyun_iam_user_login_profile
 KPFS
 	Resource
 		ksyun_kpfs_acl
@@ -464,6 +540,9 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_krds_security_groups":             dataSourceKsyunKrdsSecurityGroup(),
 			"ksyun_ks3_buckets":                      dataSourceKsyunKs3Buckets(),
 			"ksyun_certificates":                     dataSourceKsyunCertificates(),
+			"ksyun_kms_ciphertext":                   dataSourceKsyunKmsCiphertext(),
+			"ksyun_kms_secret":                       dataSourceKsyunKmsSecret(),
+			"ksyun_audit_events":                     dataSourceKsyunAuditEvents(),
 			"ksyun_ssh_keys":                         dataSourceKsyunSSHKeys(),
 			"ksyun_redis_instances":                  dataSourceRedisInstances(),
 			"ksyun_redis_security_groups":            dataSourceRedisSecurityGroups(),
@@ -484,6 +563,9 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_scaling_scheduled_tasks":          dataSourceKsyunScalingScheduledTasks(),
 			"ksyun_scaling_notifications":            dataSourceKsyunScalingNotifications(),
 			"ksyun_rabbitmqs":                        dataSourceKsyunRabbitmqs(),
+			"ksyun_kafka_instances":                  dataSourceKsyunKafkaInstances(),
+			"ksyun_kafka_topics":                     dataSourceKsyunKafkaTopics(),
+			"ksyun_kafka_consumer_groups":            dataSourceKsyunKafkaConsumerGroups(),
 			"ksyun_vpn_gateways":                     dataSourceKsyunVpnGateways(),
 			"ksyun_vpn_customer_gateways":            dataSourceKsyunVpnCustomerGateways(),
 			"ksyun_vpn_tunnels":                      dataSourceKsyunVpnTunnels(),
@@ -496,7 +578,12 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_tags":                             dataSourceKsyunTags(),
 			"ksyun_auto_snapshot_policy":             dataSourceKsyunAutoSnapshotPolicy(),
 			"ksyun_data_guard_group":                 dataSourceKsyunDataGuardGroup(),
+			"ksyun_spot_prices":                      dataSourceKsyunSpotPrices(),
+			"ksyun_dedicated_hosts":                  dataSourceKsyunDedicatedHosts(),
+			"ksyun_instance_types":                   dataSourceKsyunInstanceTypes(),
+			"ksyun_price_estimate":                   dataSourceKsyunPriceEstimate(),
 			"ksyun_krds_parameter_group":             dataSourceKsyunKrdsParameterGroup(),
+			"ksyun_krds_backups":                     dataSourceKsyunKrdsBackups(),
 			"ksyun_auto_snapshot_volume_association": dataSourceKsyunAutoSnapshotVolumeAssociation(),
 			"ksyun_knads":                            dataSourceKsyunKnads(),
 			"ksyun_dnats":                            dataSourceKsyunDnats(),
@@ -514,9 +601,13 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_kcrs_webhook_triggers": dataSourceKsyunKcrsWebhookTriggers(),
 
 			// iam
-			"ksyun_iam_users":  dataSourceKsyunIamUsers(),
-			"ksyun_iam_roles":  dataSourceKsyunIamRoles(),
-			"ksyun_iam_groups": dataSourceKsyunIamGroups(),
+			"ksyun_iam_users":             dataSourceKsyunIamUsers(),
+			"ksyun_iam_roles":             dataSourceKsyunIamRoles(),
+			"ksyun_iam_groups":            dataSourceKsyunIamGroups(),
+			"ksyun_iam_projects":          dataSourceKsyunIamProjects(),
+			"ksyun_iam_policy_document":   dataSourceKsyunIamPolicyDocument(),
+			"ksyun_iam_policies":          dataSourceKsyunIamPolicies(),
+			"ksyun_iam_policy_simulation": dataSourceKsyunIamPolicySimulation(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"ksyun_alb":                              resourceKsyunAlb(),
@@ -536,6 +627,8 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_subnet":                           resourceKsyunSubnet(),
 			"ksyun_instance":                         resourceKsyunInstance(),
 			"ksyun_sqlserver":                        resourceKsyunSqlServer(),
+			"ksyun_sqlserver_rr":                     resourceKsyunSqlServerRr(),
+			"ksyun_sqlserver_backup":                 resourceKsyunSqlServerBackup(),
 			"ksyun_kec_network_interface":            resourceKsyunKecNetworkInterface(),
 			"ksyun_kec_network_interface_attachment": resourceKsyunKecNetworkInterfaceAttachment(),
 			"ksyun_krds":                             resourceKsyunKrds(),
@@ -543,16 +636,26 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_krds_security_group":              resourceKsyunKrdsSecurityGroup(),
 			"ksyun_krds_security_group_rule":         resourceKsyunKrdsSecurityGroupRule(),
 			"ksyun_certificate":                      resourceKsyunCertificate(),
+			"ksyun_ssl_certificate_order":            resourceKsyunSslCertificateOrder(),
+			"ksyun_ssl_certificate_order_validation": resourceKsyunSslCertificateOrderValidation(),
+			"ksyun_kms_key":                          resourceKsyunKmsKey(),
+			"ksyun_audit_trail":                      resourceKsyunAuditTrail(),
 			"ksyun_ssh_key":                          resourceKsyunSSHKey(),
 			"ksyun_redis_instance":                   resourceRedisInstance(),
 			"ksyun_redis_instance_node":              resourceRedisInstanceNode(),
 			"ksyun_redis_sec_group":                  resourceRedisSecurityGroup(),
 			"ksyun_redis_sec_group_rule":             resourceRedisSecurityGroupRule(),
 			"ksyun_redis_sec_group_allocate":         resourceRedisSecurityGroupAllocate(),
+			"ksyun_redis_backup":                     resourceRedisBackup(),
+			"ksyun_redis_parameter_group":            resourceRedisParameterGroup(),
+			"ksyun_redis_account":                    resourceRedisAccount(),
 			"ksyun_mongodb_instance":                 resourceKsyunMongodbInstance(),
 			"ksyun_mongodb_shard_instance":           resourceKsyunMongodbShardInstance(),
 			"ksyun_mongodb_shard_instance_node":      resourceKsyunMongodbShardInstanceNode(),
 			"ksyun_mongodb_security_rule":            resourceKsyunMongodbSecurityRule(),
+			"ksyun_mongodb_backup":                   resourceKsyunMongodbBackup(),
+			"ksyun_mongodb_backup_policy":            resourceKsyunMongodbBackupPolicy(),
+			"ksyun_mongodb_user":                     resourceKsyunMongodbUser(),
 			"ksyun_volume":                           resourceKsyunVolume(),
 			"ksyun_volume_attach":                    resourceKsyunVolumeAttach(),
 			"ksyun_snapshot":                         resourceKsyunSnapshot(),
@@ -571,6 +674,16 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_scaling_notification":             resourceKsyunScalingNotification(),
 			"ksyun_rabbitmq_instance":                resourceKsyunRabbitmq(),
 			"ksyun_rabbitmq_security_rule":           resourceKsyunRabbitmqSecurityRule(),
+			"ksyun_rabbitmq_vhost":                   resourceKsyunRabbitmqVhost(),
+			"ksyun_rabbitmq_user":                    resourceKsyunRabbitmqUser(),
+			"ksyun_rabbitmq_permission":              resourceKsyunRabbitmqPermission(),
+			"ksyun_rabbitmq_exchange":                resourceKsyunRabbitmqExchange(),
+			"ksyun_rabbitmq_queue":                   resourceKsyunRabbitmqQueue(),
+			"ksyun_rabbitmq_binding":                 resourceKsyunRabbitmqBinding(),
+			"ksyun_kafka_instance":                   resourceKsyunKafkaInstance(),
+			"ksyun_kafka_topic":                      resourceKsyunKafkaTopic(),
+			"ksyun_kafka_consumer_group":             resourceKsyunKafkaConsumerGroup(),
+			"ksyun_kafka_acl":                        resourceKsyunKafkaAcl(),
 			"ksyun_network_acl":                      resourceKsyunNetworkAcl(),
 			"ksyun_network_acl_entry":                resourceKsyunNetworkAclEntry(),
 			"ksyun_network_acl_associate":            resourceKsyunNetworkAclAssociate(),
@@ -588,7 +701,18 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_auto_snapshot_policy":             resourceKsyunAutoSnapshotPolicy(),
 			"ksyun_auto_snapshot_volume_association": resourceKsyunAutoSnapshotVolumeAssociation(),
 			"ksyun_data_guard_group":                 resourceKsyunDataGuardGroup(),
+			"ksyun_kec_launch_template":              resourceKsyunKecLaunchTemplate(),
+			"ksyun_kec_launch_template_version":      resourceKsyunKecLaunchTemplateVersion(),
+			"ksyun_dedicated_host":                   resourceKsyunDedicatedHost(),
+			"ksyun_image":                            resourceKsyunImage(),
+			"ksyun_image_copy":                       resourceKsyunImageCopy(),
+			"ksyun_image_share_permission":           resourceKsyunImageSharePermission(),
 			"ksyun_krds_parameter_group":             resourceKsyunKrdsParameterGroup(),
+			"ksyun_krds_database":                    resourceKsyunKrdsDatabase(),
+			"ksyun_krds_account":                     resourceKsyunKrdsAccount(),
+			"ksyun_krds_backup":                      resourceKsyunKrdsBackup(),
+			"ksyun_krds_backup_policy":               resourceKsyunKrdsBackupPolicy(),
+			"ksyun_krds_proxy":                       resourceKsyunKrdsProxy(),
 			"ksyun_knad":                             resourceKsyunKnad(),
 			"ksyun_knad_associate":                   resourceKsyunKnadAssociate(),
 			"ksyun_nat_instance_bandwidth_limit":     resourceKsyunNatInstanceBandwidthLimit(),
@@ -615,11 +739,17 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_tag_v2_attachment": resourceKsyunTagv2Attachment(),
 
 			// iam
-			"ksyun_iam_user":            resourceKsyunIamUser(),
-			"ksyun_iam_role":            resourceKsyunIamRole(),
-			"ksyun_iam_group":           resourceKsyunIamGroup(),
-			"ksyun_iam_policy":          resourceKsyunIamPolicy(),
-			"ksyun_iam_relation_policy": resourceKsyunIamRelationPolicy(),
+			"ksyun_iam_user":               resourceKsyunIamUser(),
+			"ksyun_iam_role":               resourceKsyunIamRole(),
+			"ksyun_iam_group":              resourceKsyunIamGroup(),
+			"ksyun_iam_policy":             resourceKsyunIamPolicy(),
+			"ksyun_iam_relation_policy":    resourceKsyunIamRelationPolicy(),
+			"ksyun_iam_project":            resourceKsyunIamProject(),
+			"ksyun_iam_project_member":     resourceKsyunIamProjectMember(),
+			"ksyun_iam_group_membership":   resourceKsyunIamGroupMembership(),
+			"ksyun_iam_access_key":         resourceKsyunIamAccessKey(),
+			"ksyun_iam_user_login_profile": resourceKsyunIamUserLoginProfile(),
+			"ksyun_iam_instance_profile":   resourceKsyunIamInstanceProfile(),
 
 			// security group
 			"ksyun_security_group":            resourceKsyunSecurityGroup(),
@@ -0,0 +1,155 @@
+/*
+Provides an audit trail resource, which delivers the API events of the account to a KS3 bucket.
+
+The events of the account are recorded by the audit service whether there is a trail or not,
+but they're kept for 90 days only, the trail keeps them in the bucket for compliance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_ks3_bucket" "audit" {
+	  bucket = "tf-audit-logs"
+	}
+
+	resource "ksyun_audit_trail" "default" {
+	  trail_name     = "tf-audit-trail"
+	  event_types    = ["Write"]
+	  regions        = ["cn-beijing-6", "cn-shanghai-2"]
+	  bucket_name    = ksyun_ks3_bucket.audit.bucket
+	  prefix         = "audit/"
+	  retention_days = 365
+	}
+
+```
+
+# Import
+
+Audit trail can be imported using the `trail_name`, e.g.
+
+```
+$ terraform import ksyun_audit_trail.default tf-audit-trail
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunAuditTrail() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunAuditTrailCreate,
+		Read:   resourceKsyunAuditTrailRead,
+		Update: resourceKsyunAuditTrailUpdate,
+		Delete: resourceKsyunAuditTrailDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"trail_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The name of the trail, which is unique in the account.",
+			},
+			"event_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Read", "Write"}, false),
+				},
+				Set:         schema.HashString,
+				Description: "The types of the events delivered by the trail. Valid values: `Read`, `Write`. All the events are delivered if not set.",
+			},
+			"regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The regions whose events are delivered by the trail. The events of all the regions are delivered if not set.",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the KS3 bucket which the events are delivered to.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The prefix of the keys of the event files in the bucket.",
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 3650),
+				Description:  "The days to keep the event files in the bucket. The files are kept forever if not set.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the trail delivers the events. Default is `true`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the trail, `Enabled` or `Disabled`.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the trail is created.",
+			},
+		},
+	}
+}
+
+func resourceKsyunAuditTrailCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	auditService := AuditService{meta.(*KsyunClient)}
+	err = auditService.CreateAuditTrail(d, resourceKsyunAuditTrail())
+	if err != nil {
+		return fmt.Errorf("error on creating audit trail: %s", err)
+	}
+	return resourceKsyunAuditTrailRead(d, meta)
+}
+
+func resourceKsyunAuditTrailRead(d *schema.ResourceData, meta interface{}) (err error) {
+	auditService := AuditService{meta.(*KsyunClient)}
+	err = auditService.ReadAndSetAuditTrail(d, resourceKsyunAuditTrail())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading audit trail %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunAuditTrailUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	auditService := AuditService{meta.(*KsyunClient)}
+	err = auditService.ModifyAuditTrail(d, resourceKsyunAuditTrail())
+	if err != nil {
+		return fmt.Errorf("error on updating audit trail %q, %s", d.Id(), err)
+	}
+	return resourceKsyunAuditTrailRead(d, meta)
+}
+
+func resourceKsyunAuditTrailDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	auditService := AuditService{meta.(*KsyunClient)}
+	err = auditService.RemoveAuditTrail(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting audit trail %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKsyunAuditTrail_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_audit_trail.default",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAuditTrailDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditTrailConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_audit_trail.default"),
+					resource.TestCheckResourceAttr("ksyun_audit_trail.default", "event_types.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_audit_trail.default", "prefix", "audit/"),
+					resource.TestCheckResourceAttr("ksyun_audit_trail.default", "status", "Enabled"),
+				),
+			},
+			{
+				Config: testAccAuditTrailUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_audit_trail.default", "event_types.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_audit_trail.default", "retention_days", "180"),
+					resource.TestCheckResourceAttr("ksyun_audit_trail.default", "status", "Disabled"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_audit_trail.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuditTrailDestroy(s *terraform.State) error {
+	auditService := AuditService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_audit_trail" {
+			continue
+		}
+		_, err := auditService.ReadAuditTrail(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("audit trail %s still exists", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+const testAccAuditTrailConfig = `
+resource "ksyun_ks3_bucket" "audit" {
+  bucket = "bucket-tf-acc-audit"
+}
+
+resource "ksyun_audit_trail" "default" {
+  trail_name     = "tf-acc-audit-trail"
+  event_types    = ["Write"]
+  bucket_name    = ksyun_ks3_bucket.audit.bucket
+  prefix         = "audit/"
+  retention_days = 365
+}`
+
+const testAccAuditTrailUpdateConfig = `
+resource "ksyun_ks3_bucket" "audit" {
+  bucket = "bucket-tf-acc-audit"
+}
+
+resource "ksyun_audit_trail" "default" {
+  trail_name     = "tf-acc-audit-trail"
+  event_types    = ["Read", "Write"]
+  bucket_name    = ksyun_ks3_bucket.audit.bucket
+  prefix         = "audit/"
+  retention_days = 180
+  enabled        = false
+}`
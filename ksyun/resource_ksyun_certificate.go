@@ -13,6 +13,12 @@ Provides an ksyun_certificate resource.
 
 ```
 
+# Rotation
+
+Changing `public_key` or `private_key` uploads a new certificate, moves the SLB and ALB listeners which use the old certificate to the new one,
+and then deletes the old certificate, so the listeners keep serving during the rotation.
+The `certificate_id` changes after the rotation, please reference `certificate_id` instead of `id` in the listeners.
+
 # Import
 
 ksyun_certificate can be imported using the id, e.g.
@@ -37,6 +43,7 @@ func resourceKsyunCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceKsyunCertificateCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"certificate_name": {
@@ -47,23 +54,90 @@ func resourceKsyunCertificate() *schema.Resource {
 			"private_key": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "The Private key of the certificate.",
+				Sensitive:   true,
+				Description: "The Private key of the certificate. Changing it rotates the certificate.",
 			},
 			"public_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The Public key of the certificate.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCertificatePem,
+				Description:  "The Public key of the certificate in PEM format, the certificate of the server must be the first one of the chain. Changing it rotates the certificate.",
 			},
 			"certificate_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "ID of the certificate.",
 			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time from which the certificate is valid, which is parsed from `public_key`.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the certificate expires, which is parsed from `public_key`.",
+			},
+			"subject_common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the subject of the certificate.",
+			},
+			"subject_alternative_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The subject alternative names of the certificate, including the domains and the ip addresses.",
+			},
+			"issuer_common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the issuer of the certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate in hex.",
+			},
+			"fingerprint_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate in hex.",
+			},
 		},
 	}
 }
+
+// resourceKsyunCertificateCustomizeDiff shows the new expiry in the plan and marks the id unknown when the certificate is rotated.
+func resourceKsyunCertificateCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err error) {
+	if d.Id() != "" && (d.HasChange("public_key") || d.HasChange("private_key")) {
+		if err = d.SetNewComputed("certificate_id"); err != nil {
+			return err
+		}
+	}
+	if !d.HasChange("public_key") {
+		return err
+	}
+	if !d.NewValueKnown("public_key") {
+		for field := range certificateInfoFields(&certificateInfo{}) {
+			if err = d.SetNewComputed(field); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+	info, err := parseCertificatePem(d.Get("public_key").(string))
+	if err != nil {
+		return err
+	}
+	for field, value := range certificateInfoFields(info) {
+		if err = d.SetNew(field, value); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
 func resourceKsyunCertificateCreate(d *schema.ResourceData, meta interface{}) (err error) {
 	kcmService := KcmService{meta.(*KsyunClient)}
 	err = kcmService.CreateCertificate(d, resourceKsyunCertificate())
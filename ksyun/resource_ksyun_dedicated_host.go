@@ -0,0 +1,195 @@
+/*
+Provides a dedicated host resource. Instances can be placed on the host with `dedicated_host_id` of `ksyun_instance`.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_dedicated_host" "foo" {
+	  dedicated_host_name = "tf-dedicated-host"
+	  dedicated_type      = "DC2"
+	  availability_zone   = "cn-beijing-6a"
+	  charge_type         = "Monthly"
+	  purchase_time       = 1
+	  auto_placement      = "off"
+	}
+
+	resource "ksyun_instance" "foo" {
+	  dedicated_host_id = ksyun_dedicated_host.foo.id
+	  image_id          = data.ksyun_images.centos-8_0.images[0].image_id
+	  instance_type     = "N3.2B"
+	  subnet_id         = ksyun_subnet.default.id
+	  security_group_id = [ksyun_security_group.default.id]
+	  charge_type       = "Daily"
+	}
+
+```
+
+# Import
+
+Dedicated host can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_dedicated_host.foo 2ba4cd2b-8b49-4b8d-9ad9-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunDedicatedHostCreate,
+		Read:   resourceKsyunDedicatedHostRead,
+		Update: resourceKsyunDedicatedHostUpdate,
+		Delete: resourceKsyunDedicatedHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"dedicated_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the dedicated host, such as `DC1`, `DC2`.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The availability zone of the dedicated host.",
+			},
+			"charge_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Monthly",
+					"Daily",
+				}, false),
+				Description: "The charge type of the dedicated host. Valid values: `Monthly`, `Daily`.",
+			},
+			"purchase_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IntBetween(1, 36),
+				DiffSuppressFunc: purchaseTimeDiffSuppressFunc,
+				Description:      "The duration that you will buy the dedicated host, unit: month. It is required when `charge_type` is `Monthly`.",
+			},
+			"dedicated_host_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the dedicated host.",
+			},
+			"dedicated_cluster_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the dedicated cluster that the host belongs to.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The project ID of the dedicated host.",
+			},
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "on",
+				ValidateFunc: validation.StringInSlice([]string{
+					"on",
+					"off",
+				}, false),
+				Description: "Whether the instances without `dedicated_host_id` can be placed on the host automatically. Valid values: `on`, `off`. Set it to `off` to keep the host for the instances which specify it explicitly.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the dedicated host.",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vCPUs of the dedicated host.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory size of the dedicated host, unit: GB.",
+			},
+			"available_cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vCPUs that can be allocated to instances.",
+			},
+			"available_memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory size that can be allocated to instances, unit: GB.",
+			},
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances on the dedicated host.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the dedicated host.",
+			},
+		},
+	}
+}
+
+func resourceKsyunDedicatedHostCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	dedicatedHostService := DedicatedHostService{meta.(*KsyunClient)}
+	err = dedicatedHostService.CreateDedicatedHost(d, resourceKsyunDedicatedHost())
+	if err != nil {
+		return fmt.Errorf("error on creating dedicated host: %s", err)
+	}
+	return resourceKsyunDedicatedHostRead(d, meta)
+}
+
+func resourceKsyunDedicatedHostRead(d *schema.ResourceData, meta interface{}) (err error) {
+	dedicatedHostService := DedicatedHostService{meta.(*KsyunClient)}
+	err = dedicatedHostService.ReadAndSetDedicatedHost(d, resourceKsyunDedicatedHost())
+	if err != nil {
+		return fmt.Errorf("error on reading dedicated host %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	dedicatedHostService := DedicatedHostService{meta.(*KsyunClient)}
+	err = dedicatedHostService.ModifyDedicatedHost(d, resourceKsyunDedicatedHost())
+	if err != nil {
+		return fmt.Errorf("error on updating dedicated host %q, %s", d.Id(), err)
+	}
+	return resourceKsyunDedicatedHostRead(d, meta)
+}
+
+func resourceKsyunDedicatedHostDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	dedicatedHostService := DedicatedHostService{meta.(*KsyunClient)}
+	err = dedicatedHostService.RemoveDedicatedHost(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting dedicated host %q, %s", d.Id(), err)
+	}
+	return err
+}
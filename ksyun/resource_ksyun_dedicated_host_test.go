@@ -0,0 +1,71 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunDedicatedHost_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_dedicated_host.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedHostConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_dedicated_host.foo"),
+					resource.TestCheckResourceAttr("ksyun_dedicated_host.foo", "auto_placement", "off"),
+				),
+			},
+			{
+				Config: testAccDedicatedHostUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_dedicated_host.foo"),
+					resource.TestCheckResourceAttr("ksyun_dedicated_host.foo", "dedicated_host_name", "tf-acc-dedicated-host-rename"),
+					resource.TestCheckResourceAttr("ksyun_dedicated_host.foo", "auto_placement", "on"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDedicatedHostConfig = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_availability_zones" "default" {
+}
+
+resource "ksyun_dedicated_host" "foo" {
+  dedicated_host_name = "tf-acc-dedicated-host"
+  dedicated_type      = "DC2"
+  availability_zone   = data.ksyun_availability_zones.default.availability_zones.0.availability_zone_name
+  charge_type         = "Daily"
+  auto_placement      = "off"
+}
+`
+
+const testAccDedicatedHostUpdateConfig = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_availability_zones" "default" {
+}
+
+resource "ksyun_dedicated_host" "foo" {
+  dedicated_host_name = "tf-acc-dedicated-host-rename"
+  dedicated_type      = "DC2"
+  availability_zone   = data.ksyun_availability_zones.default.availability_zones.0.availability_zone_name
+  charge_type         = "Daily"
+  auto_placement      = "on"
+}
+`
@@ -0,0 +1,137 @@
+/*
+Provides an access key of the IAM user.
+
+~> **NOTE:** The secret is saved in the state in plain text unless `pgp_key` is given.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_iam_user" "user" {
+	  user_name = "tf-user"
+	}
+
+	resource "ksyun_iam_access_key" "key" {
+	  user_name = ksyun_iam_user.user.user_name
+	  pgp_key   = "keybase:some_person_that_exists"
+	}
+
+	output "encrypted_secret" {
+	  value = ksyun_iam_access_key.key.encrypted_secret
+	}
+
+```
+
+# Import
+
+IAM access key can be imported using the `user_name` and the access key id, the secret can't be imported, e.g.
+
+```
+$ terraform import ksyun_iam_access_key.key tf-user:AKLTxxxxxxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunIamAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamAccessKeyCreate,
+		Read:   resourceKsyunIamAccessKeyRead,
+		Update: resourceKsyunIamAccessKeyUpdate,
+		Delete: resourceKsyunIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamAccessKey,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM user.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Active",
+				ValidateFunc: validation.StringInSlice([]string{"Active", "Inactive"}, false),
+				Description:  "The status of the access key. Valid values: `Active`, `Inactive`. Default is `Active`.",
+			},
+			"pgp_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Either a base-64 encoded PGP public key, or a keybase username in the form `keybase:some_person_that_exists`, which is used to encrypt the secret.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret of the access key, it is set only when `pgp_key` is not given.",
+			},
+			"encrypted_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret encrypted by `pgp_key` and encoded in base64, which can be decrypted by `base64 --decode | keybase pgp decrypt`.",
+			},
+			"key_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fingerprint of the PGP key which encrypts the secret.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the access key.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.CreateIamAccessKey(d)
+	if err != nil {
+		return fmt.Errorf("error on creating access key of IAM user %q, %s", d.Get("user_name"), err)
+	}
+	return resourceKsyunIamAccessKeyRead(d, meta)
+}
+
+func resourceKsyunIamAccessKeyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.ReadAndSetIamAccessKey(d, resourceKsyunIamAccessKey())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading IAM access key %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	if d.HasChange("status") {
+		iamUserService := IamUserService{meta.(*KsyunClient)}
+		err = iamUserService.ModifyIamAccessKey(d)
+		if err != nil {
+			return fmt.Errorf("error on updating IAM access key %q, %s", d.Id(), err)
+		}
+	}
+	return resourceKsyunIamAccessKeyRead(d, meta)
+}
+
+func resourceKsyunIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.RemoveIamAccessKey(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting IAM access key %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,69 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKsyunIamAccessKey_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_iam_access_key.key",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMAccessKeyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_access_key.key"),
+					resource.TestCheckResourceAttrSet("ksyun_iam_access_key.key", "secret"),
+					resource.TestCheckResourceAttr("ksyun_iam_access_key.key", "status", "Active"),
+				),
+			},
+			{
+				Config: testAccIAMAccessKeyUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_access_key.key", "status", "Inactive"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_iam_access_key.key",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccIAMAccessKeyImportStateId,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccIAMAccessKeyImportStateId(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["ksyun_iam_access_key.key"]
+	if !ok {
+		return "", fmt.Errorf("can't find resource ksyun_iam_access_key.key")
+	}
+	return AssembleIds(rs.Primary.Attributes["user_name"], rs.Primary.ID), nil
+}
+
+const testAccIAMAccessKeyConfig = `
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-acc-access-key-user"
+}
+
+resource "ksyun_iam_access_key" "key" {
+  user_name = ksyun_iam_user.user.user_name
+}`
+
+const testAccIAMAccessKeyUpdateConfig = `
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-acc-access-key-user"
+}
+
+resource "ksyun_iam_access_key" "key" {
+  user_name = ksyun_iam_user.user.user_name
+  status    = "Inactive"
+}`
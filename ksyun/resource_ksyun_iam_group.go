@@ -34,6 +34,9 @@ func resourceKsyunIamGroup() *schema.Resource {
 		Create: resourceKsyunIamGroupCreate,
 		Read:   resourceKsyunIamGroupRead,
 		Delete: resourceKsyunIamGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "group_name"),
+		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:        schema.TypeString,
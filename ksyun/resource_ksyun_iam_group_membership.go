@@ -0,0 +1,103 @@
+/*
+Provides the membership of an IAM group, which puts the IAM users into the group.
+
+~> **NOTE:** The resource manages all the users of the group which are listed in `user_names`, the users added to the group outside terraform are kept as they are.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_iam_group" "group" {
+	  group_name = "tf-group"
+	}
+
+	resource "ksyun_iam_user" "user" {
+	  user_name = "tf-user"
+	}
+
+	resource "ksyun_iam_group_membership" "membership" {
+	  group_name = ksyun_iam_group.group.group_name
+	  user_names = [ksyun_iam_user.user.user_name]
+	}
+
+```
+
+# Import
+
+IAM group membership can be imported using the `group_name`, e.g.
+
+```
+$ terraform import ksyun_iam_group_membership.membership tf-group
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunIamGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamGroupMembershipCreate,
+		Read:   resourceKsyunIamGroupMembershipRead,
+		Update: resourceKsyunIamGroupMembershipUpdate,
+		Delete: resourceKsyunIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamGroupMembership,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM group.",
+			},
+			"user_names": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The names of the IAM users in the group.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupService := IamGroupService{meta.(*KsyunClient)}
+	err = iamGroupService.CreateIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on adding users to IAM group %q, %s", d.Get("group_name"), err)
+	}
+	return resourceKsyunIamGroupMembershipRead(d, meta)
+}
+
+func resourceKsyunIamGroupMembershipRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupService := IamGroupService{meta.(*KsyunClient)}
+	err = iamGroupService.ReadAndSetIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on reading IAM group membership %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupService := IamGroupService{meta.(*KsyunClient)}
+	err = iamGroupService.ModifyIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on updating IAM group membership %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamGroupMembershipRead(d, meta)
+}
+
+func resourceKsyunIamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupService := IamGroupService{meta.(*KsyunClient)}
+	err = iamGroupService.RemoveIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on removing users from IAM group %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,63 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIamGroupMembership_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_iam_group_membership.membership",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMGroupMembershipConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_group_membership.membership"),
+					resource.TestCheckResourceAttr("ksyun_iam_group_membership.membership", "user_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccIAMGroupMembershipUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_group_membership.membership", "user_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_iam_group_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIAMGroupMembershipBase = `
+resource "ksyun_iam_group" "group" {
+  group_name = "tf-acc-membership-group"
+}
+
+resource "ksyun_iam_user" "user1" {
+  user_name = "tf-acc-membership-user1"
+}
+
+resource "ksyun_iam_user" "user2" {
+  user_name = "tf-acc-membership-user2"
+}
+`
+
+const testAccIAMGroupMembershipConfig = testAccIAMGroupMembershipBase + `
+resource "ksyun_iam_group_membership" "membership" {
+  group_name = ksyun_iam_group.group.group_name
+  user_names = [ksyun_iam_user.user1.user_name]
+}`
+
+const testAccIAMGroupMembershipUpdateConfig = testAccIAMGroupMembershipBase + `
+resource "ksyun_iam_group_membership" "membership" {
+  group_name = ksyun_iam_group.group.group_name
+  user_names = [ksyun_iam_user.user1.user_name, ksyun_iam_user.user2.user_name]
+}`
@@ -18,6 +18,11 @@ func TestAccKsyunIamGroup_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_group.group"),
 				),
 			},
+			{
+				ResourceName:      "ksyun_iam_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
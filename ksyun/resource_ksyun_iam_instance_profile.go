@@ -0,0 +1,133 @@
+/*
+Provides an IAM instance profile resource, which passes a role to the KEC instances.
+
+The role must trust the KEC service in its `trust_policy_document`. The `role_name` of the instance profile can be referenced by
+`iam_role_name` of `ksyun_instance`, so the instance is created after the role is attached to the instance profile.
+
+# Example Usage
+
+```hcl
+
+data "ksyun_iam_policy_document" "trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["kec"]
+    }
+  }
+}
+
+resource "ksyun_iam_role" "kec" {
+  role_name             = "tf-kec-role"
+  trust_policy_document = data.ksyun_iam_policy_document.trust.json
+}
+
+resource "ksyun_iam_instance_profile" "default" {
+  instance_profile_name = "tf-kec-profile"
+  role_name             = ksyun_iam_role.kec.role_name
+}
+
+resource "ksyun_instance" "default" {
+  # ...
+  iam_role_name = ksyun_iam_instance_profile.default.role_name
+}
+
+```
+
+# Import
+
+IAM instance profile can be imported using the `instance_profile_name`, e.g.
+
+```
+$ terraform import ksyun_iam_instance_profile.default tf-kec-profile
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunIamInstanceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamInstanceProfileCreate,
+		Read:   resourceKsyunIamInstanceProfileRead,
+		Update: resourceKsyunIamInstanceProfileUpdate,
+		Delete: resourceKsyunIamInstanceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_profile_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the instance profile.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role which is passed to the instances.",
+			},
+			"instance_profile_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the instance profile.",
+			},
+			"krn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The KRN of the instance profile.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the instance profile is created.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamInstanceProfileCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamRoleService := IamRoleService{meta.(*KsyunClient)}
+	err = iamRoleService.CreateIamInstanceProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on creating IAM instance profile %q, %s", d.Get("instance_profile_name"), err)
+	}
+	return resourceKsyunIamInstanceProfileRead(d, meta)
+}
+
+func resourceKsyunIamInstanceProfileRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamRoleService := IamRoleService{meta.(*KsyunClient)}
+	err = iamRoleService.ReadAndSetIamInstanceProfile(d, resourceKsyunIamInstanceProfile())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading IAM instance profile %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamInstanceProfileUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamRoleService := IamRoleService{meta.(*KsyunClient)}
+	err = iamRoleService.ModifyIamInstanceProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on updating IAM instance profile %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamInstanceProfileRead(d, meta)
+}
+
+func resourceKsyunIamInstanceProfileDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamRoleService := IamRoleService{meta.(*KsyunClient)}
+	err = iamRoleService.RemoveIamInstanceProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting IAM instance profile %q, %s", d.Id(), err)
+	}
+	return
+}
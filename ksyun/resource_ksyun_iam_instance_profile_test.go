@@ -0,0 +1,53 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIamInstanceProfile_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_iam_instance_profile.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMInstanceProfileConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_instance_profile.default"),
+					resource.TestCheckResourceAttr("ksyun_iam_instance_profile.default", "role_name", "tf-acc-profile-role"),
+					resource.TestCheckResourceAttrSet("ksyun_iam_instance_profile.default", "krn"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_iam_instance_profile.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIAMInstanceProfileConfig = `
+data "ksyun_iam_policy_document" "trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["kec"]
+    }
+  }
+}
+
+resource "ksyun_iam_role" "kec" {
+  role_name             = "tf-acc-profile-role"
+  trust_policy_document = data.ksyun_iam_policy_document.trust.json
+}
+
+resource "ksyun_iam_instance_profile" "default" {
+  instance_profile_name = "tf-acc-profile"
+  role_name             = ksyun_iam_role.kec.role_name
+}`
@@ -35,6 +35,9 @@ func resourceKsyunIamPolicy() *schema.Resource {
 		Read:   resourceKsyunIamPolicyRead,
 		Update: resourceKsyunIamPolicyUpdate,
 		Delete: resourceKsyunIamPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamPolicy,
+		},
 		Schema: map[string]*schema.Schema{
 			"policy_name": {
 				Type:        schema.TypeString,
@@ -43,9 +46,11 @@ func resourceKsyunIamPolicy() *schema.Resource {
 				Description: "IAM PolicyName.",
 			},
 			"policy_document": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "IAM PolicyDocument.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateIamPolicyDocumentJson,
+				DiffSuppressFunc: iamPolicyDocumentDiffSuppressFunc,
+				Description:      "IAM PolicyDocument, which must be JSON, the problems of the policy grammar are only warned during plan. It can be built by the data source `ksyun_iam_policy_document`.",
 			},
 			"policy_krn": {
 				Type:        schema.TypeString,
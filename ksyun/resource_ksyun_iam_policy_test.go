@@ -24,6 +24,11 @@ func TestAccKsyunIamPolicy_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_policy.policy"),
 				),
 			},
+			{
+				ResourceName:      "ksyun_iam_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,128 @@
+/*
+Provides an IAM project resource.
+
+~> **NOTE:** The IAM api can't remove a project, destroying the resource disables the project (status `0`) instead. A disabled project is regarded as not exist, so it is recreated on the next apply. The disabled project still holds its name, so use a new `project_name` when recreating a destroyed project.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_iam_project" "project" {
+	  project_name = "tf-project"
+	  project_desc = "project for terraform"
+	}
+
+	data "ksyun_lines" "default" {
+	  line_name = "BGP"
+	}
+
+	resource "ksyun_eip" "default" {
+	  line_id       = data.ksyun_lines.default.lines.0.line_id
+	  band_width    = 1
+	  charge_type   = "PostPaidByPeak"
+	  purchase_time = 1
+	  project_id    = ksyun_iam_project.project.id
+	}
+
+```
+
+# Import
+
+IAM project can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_iam_project.project 104213
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunIamProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamProjectCreate,
+		Read:   resourceKsyunIamProjectRead,
+		Update: resourceKsyunIamProjectUpdate,
+		Delete: resourceKsyunIamProjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the project.",
+			},
+			"project_desc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the project.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the project, which can be referenced by the `project_id` of other resources.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the project.",
+			},
+			"krn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The krn of the project.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the project.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamProjectCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.CreateIamProject(d, resourceKsyunIamProject())
+	if err != nil {
+		return fmt.Errorf("error on creating IAM project %q, %s", d.Get("project_name"), err)
+	}
+	return resourceKsyunIamProjectRead(d, meta)
+}
+
+func resourceKsyunIamProjectRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.ReadAndSetIamProject(d, resourceKsyunIamProject())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading IAM project %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamProjectUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.ModifyIamProject(d, resourceKsyunIamProject())
+	if err != nil {
+		return fmt.Errorf("error on updating IAM project %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamProjectRead(d, meta)
+}
+
+func resourceKsyunIamProjectDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.DeleteIamProject(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting IAM project %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,109 @@
+/*
+Provides an IAM project member resource, which assigns an IAM user or role to a project.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_iam_project" "project" {
+	  project_name = "tf-project"
+	}
+
+	resource "ksyun_iam_user" "user" {
+	  user_name = "tf-project-user"
+	}
+
+	resource "ksyun_iam_project_member" "member" {
+	  project_id    = ksyun_iam_project.project.id
+	  identity_type = "user"
+	  identity_name = ksyun_iam_user.user.user_name
+	}
+
+```
+
+# Import
+
+IAM project member can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_iam_project_member.member ${project_id}:${identity_type}:${identity_name}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunIamProjectMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamProjectMemberCreate,
+		Read:   resourceKsyunIamProjectMemberRead,
+		Delete: resourceKsyunIamProjectMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(3, "project_id", "identity_type", "identity_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the project.",
+			},
+			"identity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "user",
+				ValidateFunc: validation.StringInSlice([]string{"user", "role"}, false),
+				Description:  "The type of the member. Valid values: `user`, `role`. Default is `user`.",
+			},
+			"identity_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM user or role.",
+			},
+			"member_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the member in the project.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamProjectMemberCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.CreateIamProjectMember(d)
+	if err != nil {
+		return fmt.Errorf("error on adding %s %q to IAM project %q, %s", d.Get("identity_type"), d.Get("identity_name"), d.Get("project_id"), err)
+	}
+	return resourceKsyunIamProjectMemberRead(d, meta)
+}
+
+func resourceKsyunIamProjectMemberRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.ReadAndSetIamProjectMember(d)
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading IAM project member %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamProjectMemberDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamProjectService := IamProjectService{meta.(*KsyunClient)}
+	err = iamProjectService.RemoveIamProjectMember(d)
+	if err != nil {
+		return fmt.Errorf("error on removing IAM project member %q, %s", d.Id(), err)
+	}
+	return
+}
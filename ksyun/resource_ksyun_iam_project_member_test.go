@@ -0,0 +1,45 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIamProjectMember_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_iam_project_member.member",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMProjectMemberConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_project_member.member"),
+					resource.TestCheckResourceAttrSet("ksyun_iam_project_member.member", "member_id"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_iam_project_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIAMProjectMemberConfig = `
+resource "ksyun_iam_project" "project" {
+  project_name = "tf-acc-project-member"
+}
+
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-acc-project-user"
+}
+
+resource "ksyun_iam_project_member" "member" {
+  project_id    = ksyun_iam_project.project.id
+  identity_name = ksyun_iam_user.user.user_name
+}`
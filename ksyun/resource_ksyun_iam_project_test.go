@@ -0,0 +1,49 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIamProject_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_iam_project.project",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMProjectConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_project.project"),
+					resource.TestCheckResourceAttrPair("ksyun_iam_project.project", "id", "ksyun_iam_project.project", "project_id"),
+				),
+			},
+			{
+				Config: testAccIAMProjectUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_project.project", "project_desc", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_iam_project.project",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIAMProjectConfig = `
+resource "ksyun_iam_project" "project" {
+  project_name = "tf-acc-project"
+  project_desc = "created by terraform"
+}`
+
+const testAccIAMProjectUpdateConfig = `
+resource "ksyun_iam_project" "project" {
+  project_name = "tf-acc-project"
+  project_desc = "updated by terraform"
+}`
@@ -22,10 +22,10 @@ resource "ksyun_iam_relation_policy" "user" {
 
 # Import
 
-IAM Policy can be imported using the `policy_name`, e.g.
+IAM relation policy can be imported using the `relation_type`, `name`, `policy_type` and `policy_name`, e.g.
 
 ```
-$ terraform import ksyun_iam_relation_policy.user
+$ terraform import ksyun_iam_relation_policy.user 1:iam_user_name:system:IAMReadOnlyAccess
 ```
 */
 
@@ -41,6 +41,9 @@ func resourceKsyunIamRelationPolicy() *schema.Resource {
 		Create: resourceKsyunIamRelationPolicyCreate,
 		Read:   resourceKsyunIamRelationPolicyRead,
 		Delete: resourceKsyunIamRelationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamRelationPolicy,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -18,6 +18,12 @@ func TestAccKsyunIamRelationPolicy_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_relation_policy.user"),
 				),
 			},
+			{
+				ResourceName:      "ksyun_iam_relation_policy.user",
+				ImportState:       true,
+				ImportStateId:     "1:username01:system:IAMReadOnlyAccess",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -1,6 +1,10 @@
 /*
 Provides a Iam Role resource.
 
+The role can be trusted by the accounts with `trust_accounts`, or by the accounts and the cloud services with `trust_policy_document`,
+which is compared in the canonical form, so changing the order of the actions or the principals doesn't cause a diff.
+The role used by the KEC instances with `iam_role_name` must trust the `kec` service, as the role `kec` in the example.
+
 # Example Usage
 
 ```hcl
@@ -11,6 +15,22 @@ resource "ksyun_iam_role" "role" {
   description = "desc"
 }
 
+data "ksyun_iam_policy_document" "trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["kec"]
+    }
+  }
+}
+
+resource "ksyun_iam_role" "kec" {
+  role_name             = "tf-kec-role"
+  trust_policy_document = data.ksyun_iam_policy_document.trust.json
+  max_session_duration  = 7200
+}
+
 ```
 
 # Import
@@ -28,13 +48,18 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceKsyunIamRole() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKsyunIamRoleCreate,
 		Read:   resourceKsyunIamRoleRead,
+		Update: resourceKsyunIamRoleUpdate,
 		Delete: resourceKsyunIamRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "role_name"),
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -43,10 +68,26 @@ func resourceKsyunIamRole() *schema.Resource {
 				Description: "IAM RoleName.",
 			},
 			"trust_accounts": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "IAM TrustAccounts.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"trust_accounts", "trust_policy_document"},
+				Description:  "IAM TrustAccounts. The ids of the accounts which can assume the role, separated by commas.",
+			},
+			"trust_policy_document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateIamTrustPolicyDocument,
+				DiffSuppressFunc: iamPolicyDocumentDiffSuppressFunc,
+				Description:      "The trust policy of the role in JSON format, which specifies the accounts and the services that can assume the role. It can be built by the data source `ksyun_iam_policy_document` with `principals`.",
+			},
+			"max_session_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(3600, 43200),
+				Description:  "The max duration of the session in seconds after assuming the role. Valid values: 3600 to 43200.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -54,6 +95,16 @@ func resourceKsyunIamRole() *schema.Resource {
 				ForceNew:    true,
 				Description: "IAM Description.",
 			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the role.",
+			},
+			"krn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The KRN of the role.",
+			},
 		},
 	}
 }
@@ -68,7 +119,12 @@ func resourceKsyunIamRoleCreate(d *schema.ResourceData, meta interface{}) (err e
 }
 
 func resourceKsyunIamRoleUpdate(d *schema.ResourceData, meta interface{}) (err error) {
-	return
+	iamRoleService := IamRoleService{meta.(*KsyunClient)}
+	err = iamRoleService.ModifyIamRole(d)
+	if err != nil {
+		return fmt.Errorf("error on updating IAM role %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamRoleRead(d, meta)
 }
 
 func resourceKsyunIamRoleRead(d *schema.ResourceData, meta interface{}) (err error) {
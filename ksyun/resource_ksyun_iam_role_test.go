@@ -18,6 +18,41 @@ func TestAccKsyunIamRole_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_role.role"),
 				),
 			},
+			{
+				ResourceName:      "ksyun_iam_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKsyunIamRole_trustPolicy(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMRoleTrustPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_role.kec"),
+					resource.TestCheckResourceAttrSet("ksyun_iam_role.kec", "trust_policy_document"),
+					resource.TestCheckResourceAttr("ksyun_iam_role.kec", "max_session_duration", "3600"),
+				),
+			},
+			{
+				Config: testAccIAMRoleTrustPolicyUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_role.kec", "max_session_duration", "7200"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_iam_role.kec",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -28,3 +63,37 @@ resource "ksyun_iam_role" "role" {
   trust_accounts = "2000096256"
   description = "desc"
 }`
+
+const testAccIAMRoleTrustPolicyConfig = `
+data "ksyun_iam_policy_document" "trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["kec"]
+    }
+  }
+}
+
+resource "ksyun_iam_role" "kec" {
+  role_name             = "tf-acc-kec-role"
+  trust_policy_document = data.ksyun_iam_policy_document.trust.json
+  max_session_duration  = 3600
+}`
+
+const testAccIAMRoleTrustPolicyUpdateConfig = `
+data "ksyun_iam_policy_document" "trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["kec", "kce"]
+    }
+  }
+}
+
+resource "ksyun_iam_role" "kec" {
+  role_name             = "tf-acc-kec-role"
+  trust_policy_document = data.ksyun_iam_policy_document.trust.json
+  max_session_duration  = 7200
+}`
@@ -42,6 +42,9 @@ func resourceKsyunIamUser() *schema.Resource {
 		Create: resourceKsyunIamUserCreate,
 		Read:   resourceKsyunIamUserRead,
 		Delete: resourceKsyunIamUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "user_name"),
+		},
 		Schema: map[string]*schema.Schema{
 			"user_name": {
 				Type:        schema.TypeString,
@@ -0,0 +1,112 @@
+/*
+Provides the console login profile of the IAM user.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_iam_user" "user" {
+	  user_name = "tf-user"
+	}
+
+	resource "ksyun_iam_user_login_profile" "profile" {
+	  user_name               = ksyun_iam_user.user.user_name
+	  password                = "Tf@Password2024"
+	  password_reset_required = true
+	}
+
+```
+
+# Import
+
+IAM user login profile can be imported using the `user_name`, the password can't be imported, e.g.
+
+```
+$ terraform import ksyun_iam_user_login_profile.profile tf-user
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunIamUserLoginProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamUserLoginProfileCreate,
+		Read:   resourceKsyunIamUserLoginProfileRead,
+		Update: resourceKsyunIamUserLoginProfileUpdate,
+		Delete: resourceKsyunIamUserLoginProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM user.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The console login password of the user.",
+			},
+			"password_reset_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the user must reset the password at the next login. Default is `false`.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the login profile.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.CreateIamLoginProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on creating login profile of IAM user %q, %s", d.Get("user_name"), err)
+	}
+	return resourceKsyunIamUserLoginProfileRead(d, meta)
+}
+
+func resourceKsyunIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.ReadAndSetIamLoginProfile(d, resourceKsyunIamUserLoginProfile())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading login profile of IAM user %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamUserLoginProfileUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.ModifyIamLoginProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on updating login profile of IAM user %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamUserLoginProfileRead(d, meta)
+}
+
+func resourceKsyunIamUserLoginProfileDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamUserService := IamUserService{meta.(*KsyunClient)}
+	err = iamUserService.RemoveIamLoginProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting login profile of IAM user %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,59 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunIamUserLoginProfile_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_iam_user_login_profile.profile",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMUserLoginProfileConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_user_login_profile.profile"),
+					resource.TestCheckResourceAttr("ksyun_iam_user_login_profile.profile", "password_reset_required", "true"),
+				),
+			},
+			{
+				Config: testAccIAMUserLoginProfileUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_user_login_profile.profile", "password_reset_required", "false"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_iam_user_login_profile.profile",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+const testAccIAMUserLoginProfileConfig = `
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-acc-login-profile-user"
+}
+
+resource "ksyun_iam_user_login_profile" "profile" {
+  user_name               = ksyun_iam_user.user.user_name
+  password                = "Tf@Password2024"
+  password_reset_required = true
+}`
+
+const testAccIAMUserLoginProfileUpdateConfig = `
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-acc-login-profile-user"
+}
+
+resource "ksyun_iam_user_login_profile" "profile" {
+  user_name = ksyun_iam_user.user.user_name
+  password  = "Tf@Password2025"
+}`
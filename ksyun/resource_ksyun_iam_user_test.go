@@ -18,6 +18,12 @@ func TestAccKsyunIamUser_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_user.user"),
 				),
 			},
+			{
+				ResourceName:            "ksyun_iam_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "password_reset_required", "open_login_protection", "open_security_protection", "view_all_project"},
+			},
 		},
 	})
 }
@@ -0,0 +1,163 @@
+/*
+Provides a custom image resource, the image can be created from an instance or a snapshot.
+
+**Note** The image can not be deleted when it is still used by scaling configurations.
+
+# Example Usage
+
+```hcl
+
+	# create image from an instance
+	resource "ksyun_image" "foo" {
+	  image_name  = "tf-golden-image"
+	  instance_id = ksyun_instance.foo.id
+	}
+
+	# create image from a system disk snapshot
+	resource "ksyun_image" "bar" {
+	  image_name  = "tf-image-from-snapshot"
+	  snapshot_id = ksyun_snapshot.foo.id
+	}
+
+```
+
+# Import
+
+Image can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_image.foo IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunImageCreate,
+		Read:   resourceKsyunImageRead,
+		Update: resourceKsyunImageUpdate,
+		Delete: resourceKsyunImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Schema: kecImageSchema(map[string]*schema.Schema{
+			"image_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the image.",
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "snapshot_id"},
+				Description:  "The ID of the instance which the image is created from. Conflict with `snapshot_id`.",
+			},
+			"snapshot_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "snapshot_id"},
+				Description:  "The ID of the system disk snapshot which the image is created from. Conflict with `instance_id`.",
+			},
+			"data_disk_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"snapshot_id"},
+				Description:   "The IDs of the EBS data disks to be included in the image. It only works with `instance_id`.",
+			},
+		}),
+	}
+}
+
+// kecImageSchema appends the computed attributes of the image to the given schema.
+func kecImageSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := map[string]*schema.Schema{
+		"image_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The state of the image.",
+		},
+		"platform": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The platform of the image.",
+		},
+		"image_source": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The source of the image.",
+		},
+		"sys_disk": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The size of the system disk.",
+		},
+		"is_public": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the image is public.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The creation time of the image.",
+		},
+	}
+	for k, v := range computed {
+		m[k] = v
+	}
+	return m
+}
+
+func resourceKsyunImageCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.CreateKecImage(d, resourceKsyunImage())
+	if err != nil {
+		return fmt.Errorf("error on creating image: %s", err)
+	}
+	return resourceKsyunImageRead(d, meta)
+}
+
+func resourceKsyunImageRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ReadAndSetKecImage(d, resourceKsyunImage())
+	if err != nil {
+		return fmt.Errorf("error on reading image %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyKecImage(d, resourceKsyunImage())
+	if err != nil {
+		return fmt.Errorf("error on updating image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageRead(d, meta)
+}
+
+func resourceKsyunImageDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.RemoveKecImage(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting image %q, %s", d.Id(), err)
+	}
+	return err
+}
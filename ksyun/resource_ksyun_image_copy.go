@@ -0,0 +1,107 @@
+/*
+Provides a resource to copy a custom image to another region.
+
+**Note** The copied image can not be deleted when it is still used by scaling configurations of the destination region.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_image_copy" "foo" {
+	  source_image_id    = ksyun_image.foo.id
+	  destination_region = "cn-shanghai-2"
+	  image_name         = "tf-golden-image-copy"
+	}
+
+```
+
+# Import
+
+Image copy can be imported using the `id` of the copied image and the destination region, e.g.
+
+```
+$ terraform import ksyun_image_copy.foo IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx:cn-shanghai-2
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunImageCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunImageCopyCreate,
+		Read:   resourceKsyunImageCopyRead,
+		Update: resourceKsyunImageCopyUpdate,
+		Delete: resourceKsyunImageCopyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importImageCopy,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+		Schema: kecImageSchema(map[string]*schema.Schema{
+			"source_image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image to be copied.",
+			},
+			"destination_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The region which the image is copied to.",
+			},
+			"image_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the copied image.",
+			},
+		}),
+	}
+}
+
+func resourceKsyunImageCopyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.CreateKecImageCopy(d, resourceKsyunImageCopy())
+	if err != nil {
+		return fmt.Errorf("error on copying image: %s", err)
+	}
+	return resourceKsyunImageCopyRead(d, meta)
+}
+
+func resourceKsyunImageCopyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ReadAndSetKecImageCopy(d, resourceKsyunImageCopy())
+	if err != nil {
+		return fmt.Errorf("error on reading image copy %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageCopyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyKecImageCopy(d, resourceKsyunImageCopy())
+	if err != nil {
+		return fmt.Errorf("error on updating image copy %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageCopyRead(d, meta)
+}
+
+func resourceKsyunImageCopyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.RemoveKecImageCopy(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting image copy %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+/*
+Provides a resource to share a custom image with other accounts.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_image_share_permission" "foo" {
+	  image_id    = ksyun_image.foo.id
+	  account_ids = ["2000012345", "2000067890"]
+	}
+
+```
+
+# Import
+
+Image share permission can be imported using the `image_id`, e.g.
+
+```
+$ terraform import ksyun_image_share_permission.foo IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunImageSharePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunImageSharePermissionCreate,
+		Read:   resourceKsyunImageSharePermissionRead,
+		Update: resourceKsyunImageSharePermissionUpdate,
+		Delete: resourceKsyunImageSharePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image to be shared.",
+			},
+			"account_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the accounts which the image is shared with.",
+			},
+		},
+	}
+}
+
+func resourceKsyunImageSharePermissionCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.CreateKecImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on sharing image %q: %s", d.Get("image_id"), err)
+	}
+	return resourceKsyunImageSharePermissionRead(d, meta)
+}
+
+func resourceKsyunImageSharePermissionRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ReadAndSetKecImageSharePermission(d, resourceKsyunImageSharePermission())
+	if err != nil {
+		return fmt.Errorf("error on reading share permission of image %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageSharePermissionUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyKecImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on updating share permission of image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageSharePermissionRead(d, meta)
+}
+
+func resourceKsyunImageSharePermissionDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.RemoveKecImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on cancelling share permission of image %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,68 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunImage_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_image.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_image.foo"),
+					resource.TestCheckResourceAttr("ksyun_image.foo", "image_state", "active"),
+					testAccCheckIDExists("ksyun_image_share_permission.foo"),
+					resource.TestCheckResourceAttr("ksyun_image_share_permission.foo", "account_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccImageUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_image.foo"),
+					resource.TestCheckResourceAttr("ksyun_image.foo", "image_name", "tf-acc-image-rename"),
+				),
+			},
+		},
+	})
+}
+
+const testAccImageBase = testAccKecLaunchTemplateBase + `
+resource "ksyun_instance" "foo" {
+  image_id          = data.ksyun_images.centos-8_0.images[0].image_id
+  instance_type     = "N3.2B"
+  subnet_id         = ksyun_subnet.default.id
+  security_group_id = [ksyun_security_group.default.id]
+  charge_type       = "Daily"
+  instance_name     = "tf-acc-image-instance"
+}
+`
+
+const testAccImageConfig = testAccImageBase + `
+resource "ksyun_image" "foo" {
+  image_name  = "tf-acc-image"
+  instance_id = ksyun_instance.foo.id
+}
+
+resource "ksyun_image_share_permission" "foo" {
+  image_id    = ksyun_image.foo.id
+  account_ids = ["2000000001"]
+}
+`
+
+const testAccImageUpdateConfig = testAccImageBase + `
+resource "ksyun_image" "foo" {
+  image_name  = "tf-acc-image-rename"
+  instance_id = ksyun_instance.foo.id
+}
+`
@@ -53,6 +53,19 @@ resource "ksyun_instance" "foo" {
   key_id          = []
   auto_create_ebs = true
 }
+
+## spot instance, it will be terminated when the market price exceeds the limit
+resource "ksyun_instance" "spot" {
+  image_id                   = data.ksyun_images.centos-8_0.images[0].image_id
+  instance_type              = "N3.2B"
+  subnet_id                  = ksyun_subnet.default.id
+  security_group_id          = [ksyun_security_group.default.id]
+  charge_type                = "HourlyInstantSettlement"
+  instance_name              = "ksyun-kec-tf-spot"
+  spot_strategy              = "SpotWithPriceLimit"
+  spot_price_limit           = 0.5
+  spot_interruption_behavior = "Terminate"
+}
 ```
 
 Import
@@ -75,10 +88,11 @@ import (
 )
 
 func instanceConfig() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	m := map[string]*schema.Schema{
 		"image_id": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			Description: "The ID for the image to use for the instance.",
 		},
 		"instance_status": {
@@ -195,7 +209,8 @@ func instanceConfig() map[string]*schema.Schema {
 		},
 		"subnet_id": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			Description: "The ID of subnet. the instance will use the subnet in the current region.",
 		},
 		"extension_network_interface": {
@@ -250,7 +265,8 @@ func instanceConfig() map[string]*schema.Schema {
 		"charge_type": {
 			Type:     schema.TypeString,
 			ForceNew: true,
-			Required: true,
+			Optional: true,
+			Computed: true,
 			ValidateFunc: validation.StringInSlice([]string{
 				"Daily",
 				"HourlyInstantSettlement",
@@ -268,7 +284,8 @@ func instanceConfig() map[string]*schema.Schema {
 		"security_group_id": {
 			Type:        schema.TypeSet,
 			Elem:        &schema.Schema{Type: schema.TypeString},
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			Set:         schema.HashString,
 			MinItems:    1,
 			Description: "Security Group to associate with.",
@@ -279,6 +296,25 @@ func instanceConfig() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "Instance private IP address can be specified when you creating new instance.",
 		},
+		"launch_template_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The ID of the launch template. The instance parameters not set in this resource are taken from the launch template. `image_id`, `subnet_id`, `security_group_id` and `charge_type` are required if it is not set.",
+		},
+		"launch_template_version": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The version number of the launch template. The default version of the launch template is used if not set.",
+		},
+		"dedicated_host_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The ID of the dedicated host that the instance is placed on.",
+		},
 		// eip和主机的绑定关系，放在绑定的resource里描述，不在vm的结构里提供这个字段
 		// 否则后绑定，资源创建完成时这个字段为空
 		// "public_ip": {
@@ -330,7 +366,7 @@ func instanceConfig() map[string]*schema.Schema {
 		"iam_role_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "name of iam role.",
+			Description: "name of iam role. It can reference the `role_name` of `ksyun_iam_instance_profile`, the role must trust the KEC service.",
 		},
 		"force_reinstall_system": {
 			Type:        schema.TypeBool,
@@ -410,6 +446,10 @@ func instanceConfig() map[string]*schema.Schema {
 			Description:      "Whether to create EBS volumes from snapshots in the custom image, default is false.",
 		},
 	}
+	for k, v := range kecSpotConfig(true) {
+		m[k] = v
+	}
+	return m
 }
 
 func resourceKsyunInstance() *schema.Resource {
@@ -426,7 +466,8 @@ func resourceKsyunInstance() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
-		Schema: instanceConfig(),
+		CustomizeDiff: kecInstanceCustomizeDiff,
+		Schema:        instanceConfig(),
 	}
 }
 
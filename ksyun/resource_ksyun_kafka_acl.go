@@ -0,0 +1,141 @@
+/*
+Provides an ACL of the Kafka instance, which grants a SASL user the operation on a topic, a consumer group or the cluster.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_kafka_acl" "default" {
+	  instance_id     = ksyun_kafka_instance.default.id
+	  resource_type   = "topic"
+	  resource_name   = ksyun_kafka_topic.default.topic_name
+	  user_name       = "orders-app"
+	  host            = "*"
+	  operation       = "Write"
+	  permission_type = "Allow"
+	}
+
+```
+
+# Import
+
+Kafka ACL can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_kafka_acl.default ${instance_id}:${resource_type}:${resource_name}:${user_name}:${host}:${operation}:${permission_type}
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKafkaAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKafkaAclCreate,
+		Read:   resourceKsyunKafkaAclRead,
+		Delete: resourceKsyunKafkaAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(7, "instance_id", "resource_type", "resource_name", "user_name", "host", "operation", "permission_type"),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the kafka instance.",
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"topic",
+					"group",
+					"cluster",
+				}, false),
+				Description: "The type of the resource. Valid values: `topic`, `group`, `cluster`.",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the resource, `*` means all the resources of the type.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The SASL user which the ACL is granted to, `*` means all the users.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+				Description: "The client host which the ACL is granted to. Default is `*`.",
+			},
+			"operation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"All",
+					"Read",
+					"Write",
+					"Create",
+					"Delete",
+					"Alter",
+					"Describe",
+				}, false),
+				Description: "The operation. Valid values: `All`, `Read`, `Write`, `Create`, `Delete`, `Alter`, `Describe`.",
+			},
+			"permission_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Allow",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Allow",
+					"Deny",
+				}, false),
+				Description: "Whether to allow or deny the operation. Valid values: `Allow`, `Deny`. Default is `Allow`.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKafkaAclCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.CreateKafkaAcl(d)
+	if err != nil {
+		return fmt.Errorf("error on creating kafka acl: %s", err)
+	}
+	return resourceKsyunKafkaAclRead(d, meta)
+}
+
+func resourceKsyunKafkaAclRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	_, err = kafkaService.ReadKafkaAcl(d)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading kafka acl %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKafkaAclDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.RemoveKafkaAcl(d)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting kafka acl %q, %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKafkaAcl_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_kafka_acl.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaAclConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kafka_acl.default"),
+					testAccCheckIDExists("ksyun_kafka_acl.group"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_kafka_acl.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccKafkaAclConfig = testAccKafkaBase + `
+resource "ksyun_kafka_topic" "default" {
+  instance_id   = ksyun_kafka_instance.default.id
+  topic_name    = "tf-acc-orders"
+  partition_num = 3
+  replica_num   = 3
+}
+
+resource "ksyun_kafka_acl" "default" {
+  instance_id   = ksyun_kafka_instance.default.id
+  resource_type = "topic"
+  resource_name = ksyun_kafka_topic.default.topic_name
+  user_name     = "orders-app"
+  operation     = "Write"
+}
+
+resource "ksyun_kafka_acl" "group" {
+  instance_id   = ksyun_kafka_instance.default.id
+  resource_type = "group"
+  resource_name = "tf-acc-orders-billing"
+  user_name     = "orders-app"
+  operation     = "Read"
+}
+`
@@ -0,0 +1,106 @@
+/*
+Provides a consumer group of the Kafka instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_kafka_consumer_group" "default" {
+	  instance_id = ksyun_kafka_instance.default.id
+	  group_name  = "orders-consumer"
+	  description = "consumers of the orders topic"
+	}
+
+```
+
+# Import
+
+Kafka consumer group can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_kafka_consumer_group.default ${instance_id}:${group_name}
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunKafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKafkaConsumerGroupCreate,
+		Read:   resourceKsyunKafkaConsumerGroupRead,
+		Update: resourceKsyunKafkaConsumerGroupUpdate,
+		Delete: resourceKsyunKafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "instance_id", "group_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the kafka instance.",
+			},
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the consumer group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the consumer group.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the consumer group, such as `Empty`, `Stable`.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKafkaConsumerGroupCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.CreateKafkaConsumerGroup(d, resourceKsyunKafkaConsumerGroup())
+	if err != nil {
+		return fmt.Errorf("error on creating kafka consumer group: %s", err)
+	}
+	return resourceKsyunKafkaConsumerGroupRead(d, meta)
+}
+
+func resourceKsyunKafkaConsumerGroupRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.ReadAndSetKafkaConsumerGroup(d, resourceKsyunKafkaConsumerGroup())
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading kafka consumer group %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKafkaConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.ModifyKafkaConsumerGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on updating kafka consumer group %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKafkaConsumerGroupRead(d, meta)
+}
+
+func resourceKsyunKafkaConsumerGroupDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.RemoveKafkaConsumerGroup(d)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting kafka consumer group %q, %s", d.Id(), err)
+	}
+	return nil
+}
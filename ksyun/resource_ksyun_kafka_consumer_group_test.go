@@ -0,0 +1,53 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKafkaConsumerGroup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_kafka_consumer_group.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaConsumerGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kafka_consumer_group.default"),
+					resource.TestCheckResourceAttr("ksyun_kafka_consumer_group.default", "description", "orders consumer"),
+				),
+			},
+			{
+				Config: testAccKafkaConsumerGroupUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kafka_consumer_group.default", "description", "orders billing consumer"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_kafka_consumer_group.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccKafkaConsumerGroupConfig = testAccKafkaBase + `
+resource "ksyun_kafka_consumer_group" "default" {
+  instance_id = ksyun_kafka_instance.default.id
+  group_name  = "tf-acc-orders-billing"
+  description = "orders consumer"
+}
+`
+
+const testAccKafkaConsumerGroupUpdateConfig = testAccKafkaBase + `
+resource "ksyun_kafka_consumer_group" "default" {
+  instance_id = ksyun_kafka_instance.default.id
+  group_name  = "tf-acc-orders-billing"
+  description = "orders billing consumer"
+}
+`
@@ -0,0 +1,216 @@
+/*
+Provides a Kafka instance resource.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_kafka_instance" "default" {
+	  instance_name     = "tf-kafka"
+	  engine_version    = "2.8.2"
+	  instance_type     = "kafka.4C8G"
+	  disk_size         = 200
+	  availability_zone = ["cn-beijing-6a", "cn-beijing-6b", "cn-beijing-6c"]
+	  vpc_id            = ksyun_vpc.default.id
+	  subnet_id         = ksyun_subnet.default.id
+	  bill_type         = 87
+	  cidrs             = ["10.0.0.0/16"]
+	}
+
+```
+
+# Import
+
+Kafka instance can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_kafka_instance.default 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKafkaInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKafkaInstanceCreate,
+		Read:   resourceKsyunKafkaInstanceRead,
+		Update: resourceKsyunKafkaInstanceUpdate,
+		Delete: resourceKsyunKafkaInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: kafkaInstanceCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instance.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The version of the kafka engine, such as `2.8.2`.",
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The spec of the broker cpu and memory, such as `kafka.4C8G`.",
+			},
+			"disk_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(100),
+				Description:  "The storage of the instance, measured in GB. It can only be increased.",
+			},
+			"availability_zone": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The availability zones of the brokers, 1 zone or 3 zones are supported.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of VPC linked to the instance.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of subnet linked to the instance.",
+			},
+			"bill_type": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.IntInSlice([]int{
+					1,
+					87,
+				}),
+				Description: "Instance charge type, Valid values are 1 (Monthly), 87 (UsageInstantSettlement).",
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("bill_type").(int) != 1
+				},
+				Description: "The duration of instance use, if `bill_type` is `1`, the duration is required.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The project id of the instance.",
+			},
+			"cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+				Set:         schema.HashString,
+				Description: "The cidrs which are allowed to access the instance.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The vip of the instance.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port of the instance.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the instance.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the instance.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration date of the instance.",
+			},
+		},
+	}
+}
+
+func kafkaInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("availability_zone") {
+		if azs := d.Get("availability_zone").([]interface{}); len(azs) == 2 {
+			return fmt.Errorf("availability_zone only supports 1 zone or 3 zones, got %d", len(azs))
+		}
+	}
+	if d.Id() == "" || !d.HasChange("disk_size") {
+		return nil
+	}
+	o, n := d.GetChange("disk_size")
+	if n.(int) < o.(int) {
+		return fmt.Errorf("disk_size can not be decreased from %d to %d", o, n)
+	}
+	return nil
+}
+
+func resourceKsyunKafkaInstanceCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.CreateKafkaInstance(d, resourceKsyunKafkaInstance())
+	if err != nil {
+		return fmt.Errorf("error on creating kafka instance: %s", err)
+	}
+	return resourceKsyunKafkaInstanceRead(d, meta)
+}
+
+func resourceKsyunKafkaInstanceRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.ReadAndSetKafkaInstance(d, resourceKsyunKafkaInstance())
+	if err != nil {
+		return fmt.Errorf("error on reading kafka instance %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKafkaInstanceUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.ModifyKafkaInstance(d, resourceKsyunKafkaInstance())
+	if err != nil {
+		return fmt.Errorf("error on updating kafka instance %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKafkaInstanceRead(d, meta)
+}
+
+func resourceKsyunKafkaInstanceDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.RemoveKafkaInstance(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting kafka instance %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKsyunKafkaInstance_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_kafka_instance.default",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKafkaInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaBase,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kafka_instance.default"),
+					resource.TestCheckResourceAttr("ksyun_kafka_instance.default", "status", "running"),
+					resource.TestCheckResourceAttr("ksyun_kafka_instance.default", "cidrs.#", "1"),
+				),
+			},
+			{
+				Config: testAccKafkaInstanceUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kafka_instance.default", "instance_name", "tf-acc-kafka-update"),
+					resource.TestCheckResourceAttr("ksyun_kafka_instance.default", "disk_size", "300"),
+					resource.TestCheckResourceAttr("ksyun_kafka_instance.default", "cidrs.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_kafka_instance.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"duration"},
+			},
+		},
+	})
+}
+
+func testAccCheckKafkaInstanceDestroy(s *terraform.State) error {
+	kafkaService := KafkaService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_kafka_instance" {
+			continue
+		}
+		data, err := kafkaService.ReadKafkaInstance(nil, rs.Primary.ID)
+		if err != nil {
+			if notFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(data) > 0 {
+			return fmt.Errorf("kafka instance %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccKafkaBase = `
+provider "ksyun" {
+  region = "cn-beijing-6"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-kafka-vpc"
+  cidr_block = "10.7.0.0/16"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-kafka-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Reserve"
+  availability_zone = "cn-beijing-6a"
+  vpc_id            = ksyun_vpc.default.id
+}
+
+resource "ksyun_kafka_instance" "default" {
+  instance_name     = "tf-acc-kafka"
+  engine_version    = "2.8.2"
+  instance_type     = "kafka.4C8G"
+  disk_size         = 200
+  availability_zone = ["cn-beijing-6a", "cn-beijing-6b", "cn-beijing-6c"]
+  vpc_id            = ksyun_vpc.default.id
+  subnet_id         = ksyun_subnet.default.id
+  bill_type         = 87
+  cidrs             = ["10.7.0.0/16"]
+}
+`
+
+const testAccKafkaInstanceUpdateConfig = `
+provider "ksyun" {
+  region = "cn-beijing-6"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-kafka-vpc"
+  cidr_block = "10.7.0.0/16"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-kafka-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Reserve"
+  availability_zone = "cn-beijing-6a"
+  vpc_id            = ksyun_vpc.default.id
+}
+
+resource "ksyun_kafka_instance" "default" {
+  instance_name     = "tf-acc-kafka-update"
+  engine_version    = "2.8.2"
+  instance_type     = "kafka.4C8G"
+  disk_size         = 300
+  availability_zone = ["cn-beijing-6a", "cn-beijing-6b", "cn-beijing-6c"]
+  vpc_id            = ksyun_vpc.default.id
+  subnet_id         = ksyun_subnet.default.id
+  bill_type         = 87
+  cidrs             = ["10.7.0.0/16", "192.168.0.0/24"]
+}
+`
@@ -0,0 +1,142 @@
+/*
+Provides a topic of the Kafka instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_kafka_topic" "default" {
+	  instance_id   = ksyun_kafka_instance.default.id
+	  topic_name    = "orders"
+	  partition_num = 6
+	  replica_num   = 3
+	  retention_ms  = 259200000
+	  configs = {
+	    "cleanup.policy"      = "delete"
+	    "min.insync.replicas" = "2"
+	  }
+	}
+
+```
+
+# Import
+
+Kafka topic can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_kafka_topic.default ${instance_id}:${topic_name}
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKafkaTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKafkaTopicCreate,
+		Read:   resourceKsyunKafkaTopicRead,
+		Update: resourceKsyunKafkaTopicUpdate,
+		Delete: resourceKsyunKafkaTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "instance_id", "topic_name"),
+		},
+		CustomizeDiff: kafkaTopicCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the kafka instance.",
+			},
+			"topic_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the topic.",
+			},
+			"partition_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the partitions. It can only be increased.",
+			},
+			"replica_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 3),
+				Description:  "The number of the replicas of each partition.",
+			},
+			"retention_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The retention time of the messages, measured in milliseconds.",
+			},
+			"configs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The topic level configs, such as `cleanup.policy`, `min.insync.replicas`.",
+			},
+		},
+	}
+}
+
+func kafkaTopicCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("partition_num") {
+		return nil
+	}
+	o, n := d.GetChange("partition_num")
+	if n.(int) < o.(int) {
+		return fmt.Errorf("partition_num can not be decreased from %d to %d", o, n)
+	}
+	return nil
+}
+
+func resourceKsyunKafkaTopicCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.CreateKafkaTopic(d, resourceKsyunKafkaTopic())
+	if err != nil {
+		return fmt.Errorf("error on creating kafka topic: %s", err)
+	}
+	return resourceKsyunKafkaTopicRead(d, meta)
+}
+
+func resourceKsyunKafkaTopicRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.ReadAndSetKafkaTopic(d, resourceKsyunKafkaTopic())
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading kafka topic %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKafkaTopicUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.ModifyKafkaTopic(d, resourceKsyunKafkaTopic())
+	if err != nil {
+		return fmt.Errorf("error on updating kafka topic %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKafkaTopicRead(d, meta)
+}
+
+func resourceKsyunKafkaTopicDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kafkaService := KafkaService{meta.(*KsyunClient)}
+	err = kafkaService.RemoveKafkaTopic(d)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting kafka topic %q, %s", d.Id(), err)
+	}
+	return nil
+}
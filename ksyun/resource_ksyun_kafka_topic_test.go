@@ -0,0 +1,65 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunKafkaTopic_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_kafka_topic.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaTopicConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kafka_topic.default"),
+					resource.TestCheckResourceAttr("ksyun_kafka_topic.default", "partition_num", "3"),
+					resource.TestCheckResourceAttr("ksyun_kafka_topic.default", "configs.cleanup.policy", "delete"),
+				),
+			},
+			{
+				Config: testAccKafkaTopicUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kafka_topic.default", "partition_num", "6"),
+					resource.TestCheckResourceAttr("ksyun_kafka_topic.default", "retention_ms", "86400000"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_kafka_topic.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configs"},
+			},
+		},
+	})
+}
+
+const testAccKafkaTopicConfig = testAccKafkaBase + `
+resource "ksyun_kafka_topic" "default" {
+  instance_id   = ksyun_kafka_instance.default.id
+  topic_name    = "tf-acc-orders"
+  partition_num = 3
+  replica_num   = 3
+  configs = {
+    "cleanup.policy" = "delete"
+  }
+}
+`
+
+const testAccKafkaTopicUpdateConfig = testAccKafkaBase + `
+resource "ksyun_kafka_topic" "default" {
+  instance_id   = ksyun_kafka_instance.default.id
+  topic_name    = "tf-acc-orders"
+  partition_num = 6
+  replica_num   = 3
+  retention_ms  = 86400000
+  configs = {
+    "cleanup.policy" = "delete"
+  }
+}
+`
@@ -4,6 +4,9 @@ Provides a KCE cluster resource.
 ~> **NOTE:** We recommend that uses the `ksyun_kce_cluster` resource to create a cluster with few `worker_config`.
 If you want to manage more worker instances in this cluster, to use the `ksyun_kce_cluster_attach_existence` or `ksyun_kce_cluster_attachment` resource to attach the worker instances to the cluster. The reason is that the `worker_config` is unchangeable and may cause the cluster to be re-created because it is marked *ForceNew*.
 
+The nodes of `master_config` and `worker_config` can be launched from a launch template with `launch_template_id` and `launch_template_version`,
+`image_id`, `subnet_id`, `security_group_id` and `charge_type` which are not set are taken from the template when the cluster is created.
+
 # Example Usage
 
 ## basic dependency resources
@@ -371,6 +374,9 @@ func instanceForNode() map[string]*schema.Schema {
 
 	m["security_group_id"].MaxItems = 1
 
+	// image_id, subnet_id, security_group_id and charge_type can be taken from the launch template,
+	// they are filled before the nodes are created, see fillKceNodesLaunchTemplate.
+
 	m["role"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
@@ -720,13 +726,15 @@ func kcePreinspection(d *schema.ResourceData) error {
 }
 
 func isDuplicationMachineType(machines []interface{}) bool {
-	m := make(map[int]bool)
+	m := make(map[string]bool)
 	for _, machine := range machines {
-		hashcode := kceInstanceNodeHashFunc()(machine)
-		if _, ok := m[hashcode]; ok {
+		// the fields taken from the launch template are empty before the nodes are created
+		mm, _ := machine.(map[string]interface{})
+		key := fmt.Sprintf("%d:%v:%v", kceInstanceNodeHashFunc()(machine), mm["launch_template_id"], mm["launch_template_version"])
+		if _, ok := m[key]; ok {
 			return true
 		}
-		m[hashcode] = true
+		m[key] = true
 	}
 	return false
 }
@@ -125,6 +125,9 @@ func resourceKsyunKceClusterAttachmentCreate(d *schema.ResourceData, meta interf
 		meta.(*KsyunClient),
 	}
 	err = s.AddNewInstances(d, resourceKsyunKceClusterAttachment())
+	if err != nil {
+		return fmt.Errorf("error on adding instance to kce cluster: %s", err)
+	}
 	return resourceKsyunKceClusterAttachmentRead(d, meta)
 }
 func resourceKsyunKceClusterAttachmentUpdate(d *schema.ResourceData, meta interface{}) (err error) {
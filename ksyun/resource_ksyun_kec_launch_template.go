@@ -0,0 +1,328 @@
+/*
+Provides a KEC launch template resource.
+
+**Note** The changes of the instance parameters are saved as a new version of the launch template,
+and the new version becomes the default version.
+
+# Example Usage
+
+```hcl
+
+	data "ksyun_images" "centos-8_0" {
+	  platform = "centos-8.0"
+	}
+
+	resource "ksyun_kec_launch_template" "foo" {
+	  launch_template_name = "tf-launch-template"
+	  version_description  = "centos 8.0"
+	  image_id             = data.ksyun_images.centos-8_0.images[0].image_id
+	  instance_type        = "N3.2B"
+	  subnet_id            = ksyun_subnet.default.id
+	  security_group_id    = [ksyun_security_group.default.id]
+	  charge_type          = "Daily"
+	  system_disk {
+	    disk_type = "SSD3.0"
+	    disk_size = 40
+	  }
+	  data_disks {
+	    disk_type            = "SSD3.0"
+	    disk_size            = 50
+	    delete_with_instance = true
+	  }
+	}
+
+	resource "ksyun_instance" "foo" {
+	  launch_template_id      = ksyun_kec_launch_template.foo.id
+	  launch_template_version = ksyun_kec_launch_template.foo.default_version_number
+	  instance_name           = "tf-instance-from-template"
+	}
+
+```
+
+# Import
+
+KEC launch template can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_kec_launch_template.foo lt-67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// kecLaunchTemplateDataConfig returns the instance parameters saved in a launch template version.
+func kecLaunchTemplateDataConfig(forceNew bool) map[string]*schema.Schema {
+	m := map[string]*schema.Schema{
+		"image_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The ID for the image to use for the instance.",
+		},
+		"instance_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The type of instance to start.",
+		},
+		"system_disk": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "System disk parameters.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disk_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"SSD3.0",
+							"EHDD",
+							"Local_SSD",
+							"ESSD_SYSTEM_PL0",
+							"ESSD_SYSTEM_PL1",
+							"ESSD_SYSTEM_PL2",
+						}, false),
+						Description: "System disk type. `Local_SSD`, Local SSD disk. `SSD3.0`, The SSD cloud disk. `EHDD`, The EHDD cloud disk, `ESSD_SYSTEM_PL0`, The x7 machine type ESSD disk, `ESSD_SYSTEM_PL1`, The x7 machine type ESSD disk, `ESSD_SYSTEM_PL2`, The x7 machine type ESSD disk.",
+					},
+					"disk_size": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(20, 500),
+						Description:  "The size of the system disk. value range: [20, 500].",
+					},
+				},
+			},
+		},
+		"data_disk_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 16000),
+			Description:  "The size of the local SSD disk.",
+		},
+		"data_disks": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    8,
+			Description: "The list of data disks created with instance.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disk_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"SSD3.0",
+							"EHDD",
+							"Local_SSD",
+							"ESSD_PL0",
+							"ESSD_PL1",
+							"ESSD_PL2",
+							"ESSD_PL3",
+						}, false),
+						Description: "Data disk type.",
+					},
+					"disk_size": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(10, 16000),
+						Description:  "Data disk size. value range: [10, 16000].",
+					},
+					"disk_snapshot_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "When the cloud disk opens, the snapshot id is entered.",
+					},
+					"delete_with_instance": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Delete this data disk when the instance is destroyed. It only works on EBS disk.",
+					},
+				},
+			},
+		},
+		"subnet_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The ID of subnet.",
+		},
+		"security_group_id": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "Security Group to associate with.",
+		},
+		"instance_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Password to an instance is a string of 8 to 32 characters.",
+		},
+		"keep_image_login": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Keep the initial settings of the custom image.",
+		},
+		"key_id": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The certificate id of the instance.",
+		},
+		"charge_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Daily",
+				"HourlyInstantSettlement",
+			}, false),
+			Description: "charge type of the instance.",
+		},
+		"instance_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of instance, which contains 2-64 characters and only support Chinese, English, numbers.",
+		},
+		"host_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The hostname of the instance. only effective when image support cloud-init.",
+		},
+		"sriov_net_support": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"true",
+				"false",
+			}, false),
+			Description: "whether support networking enhancement.",
+		},
+		"project_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The project instance belongs to.",
+		},
+		"data_guard_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Add instance being created to a disaster tolerance group.",
+		},
+		"user_data": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The user data to be specified into this instance. Must be encrypted in base64 format and limited in 16 KB. only effective when image support cloud-init.",
+		},
+		"iam_role_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "name of iam role.",
+		},
+	}
+	for k, v := range kecSpotConfig(false) {
+		m[k] = v
+	}
+	if forceNew {
+		for _, v := range m {
+			v.ForceNew = true
+		}
+	}
+	return m
+}
+
+func kecLaunchTemplateDataKeys() []string {
+	var keys []string
+	for k := range kecLaunchTemplateDataConfig(false) {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func resourceKsyunKecLaunchTemplate() *schema.Resource {
+	m := kecLaunchTemplateDataConfig(false)
+	m["launch_template_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the launch template.",
+	}
+	m["version_description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The description of the default version.",
+	}
+	m["default_version_number"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The default version number of the launch template.",
+	}
+	m["latest_version_number"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The latest version number of the launch template.",
+	}
+	m["create_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The creation time of the launch template.",
+	}
+
+	return &schema.Resource{
+		Create: resourceKsyunKecLaunchTemplateCreate,
+		Read:   resourceKsyunKecLaunchTemplateRead,
+		Update: resourceKsyunKecLaunchTemplateUpdate,
+		Delete: resourceKsyunKecLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: m,
+	}
+}
+
+func resourceKsyunKecLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.CreateKecLaunchTemplate(d, resourceKsyunKecLaunchTemplate())
+	if err != nil {
+		return fmt.Errorf("error on creating launch template: %s", err)
+	}
+	return resourceKsyunKecLaunchTemplateRead(d, meta)
+}
+
+func resourceKsyunKecLaunchTemplateRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.ReadAndSetKecLaunchTemplate(d, resourceKsyunKecLaunchTemplate())
+	if err != nil {
+		return fmt.Errorf("error on reading launch template %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKecLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.ModifyKecLaunchTemplate(d, resourceKsyunKecLaunchTemplate())
+	if err != nil {
+		return fmt.Errorf("error on updating launch template %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKecLaunchTemplateRead(d, meta)
+}
+
+func resourceKsyunKecLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.RemoveKecLaunchTemplate(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting launch template %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunKecLaunchTemplate_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_kec_launch_template.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKecLaunchTemplateConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kec_launch_template.foo"),
+					resource.TestCheckResourceAttr("ksyun_kec_launch_template.foo", "instance_type", "N3.2B"),
+					resource.TestCheckResourceAttr("ksyun_kec_launch_template.foo", "default_version_number", "1"),
+				),
+			},
+			// a new version is created and set as default when the template data changes
+			{
+				Config: testAccKecLaunchTemplateUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kec_launch_template.foo"),
+					resource.TestCheckResourceAttr("ksyun_kec_launch_template.foo", "instance_type", "N3.4B"),
+					resource.TestCheckResourceAttr("ksyun_kec_launch_template.foo", "default_version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKecLaunchTemplateBase = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+data "ksyun_images" "centos-8_0" {
+  platform = "centos-8.0"
+}
+
+data "ksyun_availability_zones" "default" {
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-launch-template-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-launch-template-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = ksyun_vpc.default.id
+  availability_zone = data.ksyun_availability_zones.default.availability_zones.0.availability_zone_name
+}
+
+resource "ksyun_security_group" "default" {
+  vpc_id              = ksyun_vpc.default.id
+  security_group_name = "tf-acc-launch-template-sg"
+}
+`
+
+const testAccKecLaunchTemplateConfig = testAccKecLaunchTemplateBase + `
+resource "ksyun_kec_launch_template" "foo" {
+  launch_template_name = "tf-acc-launch-template"
+  version_description  = "v1"
+  image_id             = data.ksyun_images.centos-8_0.images[0].image_id
+  instance_type        = "N3.2B"
+  subnet_id            = ksyun_subnet.default.id
+  security_group_id    = [ksyun_security_group.default.id]
+  charge_type          = "Daily"
+  system_disk {
+    disk_type = "SSD3.0"
+    disk_size = 40
+  }
+}
+`
+
+const testAccKecLaunchTemplateUpdateConfig = testAccKecLaunchTemplateBase + `
+resource "ksyun_kec_launch_template" "foo" {
+  launch_template_name = "tf-acc-launch-template"
+  version_description  = "v2"
+  image_id             = data.ksyun_images.centos-8_0.images[0].image_id
+  instance_type        = "N3.4B"
+  subnet_id            = ksyun_subnet.default.id
+  security_group_id    = [ksyun_security_group.default.id]
+  charge_type          = "Daily"
+  system_disk {
+    disk_type = "SSD3.0"
+    disk_size = 40
+  }
+}
+`
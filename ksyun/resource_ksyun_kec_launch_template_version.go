@@ -0,0 +1,121 @@
+/*
+Provides a version of KEC launch template.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_kec_launch_template_version" "foo" {
+	  launch_template_id  = ksyun_kec_launch_template.foo.id
+	  version_description = "bigger instance"
+	  image_id            = data.ksyun_images.centos-8_0.images[0].image_id
+	  instance_type       = "N3.4B"
+	  subnet_id           = ksyun_subnet.default.id
+	  security_group_id   = [ksyun_security_group.default.id]
+	  charge_type         = "Daily"
+	  set_as_default      = true
+	}
+
+```
+
+# Import
+
+KEC launch template version can be imported using the `launch_template_id:version_number`, e.g.
+
+```
+$ terraform import ksyun_kec_launch_template_version.foo lt-67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx:2
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunKecLaunchTemplateVersion() *schema.Resource {
+	m := kecLaunchTemplateDataConfig(true)
+	m["launch_template_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The ID of the launch template.",
+	}
+	m["version_description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The description of the version.",
+	}
+	m["set_as_default"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to set this version as the default version of the launch template.",
+	}
+	m["version_number"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of the version.",
+	}
+	m["is_default_version"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the version is the default version of the launch template.",
+	}
+
+	return &schema.Resource{
+		Create: resourceKsyunKecLaunchTemplateVersionCreate,
+		Read:   resourceKsyunKecLaunchTemplateVersionRead,
+		Update: resourceKsyunKecLaunchTemplateVersionUpdate,
+		Delete: resourceKsyunKecLaunchTemplateVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKecLaunchTemplateVersion,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: m,
+	}
+}
+
+func resourceKsyunKecLaunchTemplateVersionCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.CreateKecLaunchTemplateVersion(d, resourceKsyunKecLaunchTemplateVersion())
+	if err != nil {
+		return fmt.Errorf("error on creating launch template version: %s", err)
+	}
+	return resourceKsyunKecLaunchTemplateVersionRead(d, meta)
+}
+
+func resourceKsyunKecLaunchTemplateVersionRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.ReadAndSetKecLaunchTemplateVersion(d, resourceKsyunKecLaunchTemplateVersion())
+	if err != nil {
+		return fmt.Errorf("error on reading launch template version %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKecLaunchTemplateVersionUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.ModifyKecLaunchTemplateVersion(d)
+	if err != nil {
+		return fmt.Errorf("error on updating launch template version %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKecLaunchTemplateVersionRead(d, meta)
+}
+
+func resourceKsyunKecLaunchTemplateVersionDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kecService := KecService{meta.(*KsyunClient)}
+	err = kecService.RemoveKecLaunchTemplateVersion(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting launch template version %q, %s", d.Id(), err)
+	}
+	return err
+}
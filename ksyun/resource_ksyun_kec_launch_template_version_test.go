@@ -0,0 +1,43 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunKecLaunchTemplateVersion_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_kec_launch_template_version.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKecLaunchTemplateVersionConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kec_launch_template_version.foo"),
+					resource.TestCheckResourceAttr("ksyun_kec_launch_template_version.foo", "version_number", "2"),
+					resource.TestCheckResourceAttr("ksyun_kec_launch_template_version.foo", "is_default_version", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKecLaunchTemplateVersionConfig = testAccKecLaunchTemplateConfig + `
+resource "ksyun_kec_launch_template_version" "foo" {
+  launch_template_id  = ksyun_kec_launch_template.foo.id
+  version_description = "bigger instance"
+  image_id            = data.ksyun_images.centos-8_0.images[0].image_id
+  instance_type       = "N3.4B"
+  subnet_id           = ksyun_subnet.default.id
+  security_group_id   = [ksyun_security_group.default.id]
+  charge_type         = "Daily"
+  set_as_default      = true
+}
+`
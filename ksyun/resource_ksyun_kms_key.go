@@ -0,0 +1,137 @@
+/*
+Provides a KMS key resource, which encrypts the EBS volumes, the KS3 buckets and the secrets.
+
+The key can't be deleted immediately, it's scheduled to be deleted after `deletion_window_in_days` when the resource is destroyed.
+
+# Example Usage
+
+```hcl
+
+resource "ksyun_kms_key" "default" {
+  alias                   = "tf-key"
+  description             = "the key created by terraform"
+  enable_key_rotation     = true
+  deletion_window_in_days = 7
+}
+
+```
+
+# Import
+
+KMS key can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_kms_key.default 1c3e6c1e-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKmsKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKmsKeyCreate,
+		Read:   resourceKsyunKmsKeyRead,
+		Update: resourceKsyunKmsKeyUpdate,
+		Delete: resourceKsyunKmsKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The alias of the key.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the key.",
+			},
+			"key_usage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ENCRYPT/DECRYPT",
+				ValidateFunc: validation.StringInSlice([]string{"ENCRYPT/DECRYPT"}, false),
+				Description:  "The usage of the key. Valid values: `ENCRYPT/DECRYPT`. Default is `ENCRYPT/DECRYPT`.",
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the key is enabled. Default is `true`.",
+			},
+			"enable_key_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the key material is rotated automatically every year. Default is `false`.",
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(7, 30),
+				Description:  "The days after which the key is deleted when the resource is destroyed. Valid values: 7 to 30. Default is `7`.",
+			},
+			"key_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the key is created.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKmsKeyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kmsService := KmsService{meta.(*KsyunClient)}
+	err = kmsService.CreateKmsKey(d, resourceKsyunKmsKey())
+	if err != nil {
+		return fmt.Errorf("error on creating kms key %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKmsKeyRead(d, meta)
+}
+
+func resourceKsyunKmsKeyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kmsService := KmsService{meta.(*KsyunClient)}
+	err = kmsService.ReadAndSetKmsKey(d, resourceKsyunKmsKey())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading kms key %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKmsKeyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kmsService := KmsService{meta.(*KsyunClient)}
+	err = kmsService.ModifyKmsKey(d)
+	if err != nil {
+		return fmt.Errorf("error on updating kms key %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKmsKeyRead(d, meta)
+}
+
+func resourceKsyunKmsKeyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kmsService := KmsService{meta.(*KsyunClient)}
+	err = kmsService.RemoveKmsKey(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting kms key %q, %s", d.Id(), err)
+	}
+	return err
+}
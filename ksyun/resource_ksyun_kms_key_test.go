@@ -0,0 +1,76 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKsyunKmsKey_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_kms_key.default",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKeyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_kms_key.default"),
+					resource.TestCheckResourceAttr("ksyun_kms_key.default", "key_state", "Enabled"),
+					resource.TestCheckResourceAttr("ksyun_kms_key.default", "enable_key_rotation", "false"),
+				),
+			},
+			{
+				Config: testAccKmsKeyUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kms_key.default", "alias", "tf-acc-key-update"),
+					resource.TestCheckResourceAttr("ksyun_kms_key.default", "key_state", "Disabled"),
+					resource.TestCheckResourceAttr("ksyun_kms_key.default", "enable_key_rotation", "true"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_kms_key.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func testAccCheckKmsKeyDestroy(s *terraform.State) error {
+	kmsService := KmsService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_kms_key" {
+			continue
+		}
+		_, err := kmsService.ReadKmsKey(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("kms key %s still exists", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+const testAccKmsKeyConfig = `
+resource "ksyun_kms_key" "default" {
+  alias       = "tf-acc-key"
+  description = "tf acc test"
+}`
+
+const testAccKmsKeyUpdateConfig = `
+resource "ksyun_kms_key" "default" {
+  alias                   = "tf-acc-key-update"
+  description             = "tf acc test update"
+  is_enabled              = false
+  enable_key_rotation     = true
+  deletion_window_in_days = 30
+}`
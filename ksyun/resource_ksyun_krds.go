@@ -147,6 +147,24 @@ Provides an RDS instance resource. A DB instance is an isolated database environ
 	  instance_has_eip = true
 	}
 
+# Create a RDS MySQL instance from a backup of another instance
+
+	resource "ksyun_krds" "restored" {
+	  db_instance_class    = "db.ram.2|db.disk.21"
+	  db_instance_name     = "restored-for-analysis"
+	  db_instance_type     = "HRDS"
+	  engine               = "mysql"
+	  engine_version       = "5.7"
+	  master_user_name     = "admin"
+	  master_user_password = "123qweASD123"
+	  vpc_id               = "${ksyun_vpc.default.id}"
+	  subnet_id            = "${ksyun_subnet.foo.id}"
+	  restore_from {
+	    source_db_instance_identifier = ksyun_krds.my_rds_xx.id
+	    backup_id                     = ksyun_krds_backup.foo.id
+	  }
+	}
+
 ```
 
 # Import
@@ -178,7 +196,7 @@ func resourceKsyunKrds() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: krdsInstanceCustomizeDiff(),
+		CustomizeDiff: krdsInstanceCustomizeDiff(checkKrdsParametersDiff),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(300 * time.Minute),
 			Update: schema.DefaultTimeout(300 * time.Minute),
@@ -376,6 +394,36 @@ func resourceKsyunKrds() *schema.Resource {
 				Default:     false,
 				Description: "Set it to true to make some parameter efficient when modifying them. Default to false.",
 			},
+			"restore_from": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Create the instance from a backup or a point in time of the source instance. The `engine` and `engine_version` must be the same as the source instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_db_instance_identifier": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the source instance.",
+						},
+						"backup_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The ID of the backup to restore from. Conflict with `restore_time`.",
+						},
+						"restore_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.ValidateRFC3339TimeString,
+							Description:  "The point in time to restore to, in RFC3339 format, such as `2023-01-01T08:00:00+08:00`. Conflict with `backup_id`.",
+						},
+					},
+				},
+			},
 
 			"tags": tagsSchema(),
 		},
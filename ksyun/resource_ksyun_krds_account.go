@@ -0,0 +1,148 @@
+/*
+Provides an account resource of the KRDS instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_krds_account" "foo" {
+	  db_instance_identifier = ksyun_krds.default.id
+	  account_name           = "tf_account"
+	  account_password       = "123qweASD123"
+	  description            = "created by terraform"
+	  privileges {
+	    database_name = ksyun_krds_database.foo.database_name
+	    privilege     = "ReadWrite"
+	  }
+	}
+
+```
+
+# Import
+
+KRDS account can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_krds_account.foo ${db_instance_identifier}:${account_name}
+```
+
+**Note** The `account_password` can not be read from the API, so it will be empty after import.
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKrdsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsAccountCreate,
+		Read:   resourceKsyunKrdsAccountRead,
+		Update: resourceKsyunKrdsAccountUpdate,
+		Delete: resourceKsyunKrdsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "db_instance_identifier", "account_name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KRDS instance.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the account.",
+			},
+			"account_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the account, which contains 8-32 characters and must contain uppercase letters, lowercase letters and numbers.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the account.",
+			},
+			"privileges": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The privileges of the account on the databases.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the database.",
+						},
+						"privilege": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ReadWrite",
+								"ReadOnly",
+								"DDLOnly",
+								"DMLOnly",
+							}, false),
+							Description: "The privilege on the database. Valid values: `ReadWrite`, `ReadOnly`, `DDLOnly`, `DMLOnly`.",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the account.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsAccountCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.CreateKrdsAccount(d, resourceKsyunKrdsAccount())
+	if err != nil {
+		return fmt.Errorf("error on creating krds account: %s", err)
+	}
+	return resourceKsyunKrdsAccountRead(d, meta)
+}
+
+func resourceKsyunKrdsAccountRead(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ReadAndSetKrdsAccount(d, resourceKsyunKrdsAccount())
+	if err != nil {
+		return fmt.Errorf("error on reading krds account %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsAccountUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ModifyKrdsAccount(d, resourceKsyunKrdsAccount())
+	if err != nil {
+		return fmt.Errorf("error on updating krds account %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKrdsAccountRead(d, meta)
+}
+
+func resourceKsyunKrdsAccountDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.RemoveKrdsAccount(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting krds account %q, %s", d.Id(), err)
+	}
+	return err
+}
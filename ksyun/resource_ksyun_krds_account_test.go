@@ -0,0 +1,124 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunKrdsAccount_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_krds_account.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_krds_database.foo"),
+					testAccCheckIDExists("ksyun_krds_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "character_set", "utf8mb4"),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "privileges.#", "1"),
+				),
+			},
+			{
+				Config: testAccKrdsAccountUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_krds_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "description", "tf-acc-database-update"),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "description", "tf-acc-account-update"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_krds_account.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_password"},
+			},
+			{
+				ResourceName:      "ksyun_krds_database.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccKrdsAccountBase = `
+provider "ksyun" {
+	region = "cn-beijing-6"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-krds-account-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-krds-account-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Reserve"
+  vpc_id            = ksyun_vpc.default.id
+  gateway_ip        = "10.7.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-beijing-6a"
+}
+
+resource "ksyun_krds" "default" {
+  db_instance_class    = "db.ram.2|db.disk.50"
+  db_instance_name     = "tf-acc-krds-account"
+  db_instance_type     = "HRDS"
+  engine               = "mysql"
+  engine_version       = "5.7"
+  master_user_name     = "admin"
+  master_user_password = "123qweASD123"
+  vpc_id               = ksyun_vpc.default.id
+  subnet_id            = ksyun_subnet.default.id
+  bill_type            = "DAY"
+}
+`
+
+const testAccKrdsAccountConfig = testAccKrdsAccountBase + `
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  database_name          = "tf_acc_database"
+  character_set          = "utf8mb4"
+  description            = "tf-acc-database"
+}
+
+resource "ksyun_krds_account" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  account_name           = "tf_acc_account"
+  account_password       = "123qweASD123"
+  description            = "tf-acc-account"
+  privileges {
+    database_name = ksyun_krds_database.foo.database_name
+    privilege     = "ReadWrite"
+  }
+}
+`
+
+const testAccKrdsAccountUpdateConfig = testAccKrdsAccountBase + `
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  database_name          = "tf_acc_database"
+  character_set          = "utf8mb4"
+  description            = "tf-acc-database-update"
+}
+
+resource "ksyun_krds_account" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  account_name           = "tf_acc_account"
+  account_password       = "123qweASD456"
+  description            = "tf-acc-account-update"
+  privileges {
+    database_name = ksyun_krds_database.foo.database_name
+    privilege     = "ReadOnly"
+  }
+}
+`
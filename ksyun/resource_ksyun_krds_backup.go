@@ -0,0 +1,129 @@
+/*
+Provides an on-demand backup of the KRDS instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_krds_backup" "foo" {
+	  db_instance_identifier = ksyun_krds.default.id
+	  backup_name            = "tf-krds-backup"
+	}
+
+```
+
+# Import
+
+KRDS backup can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_krds_backup.foo ${db_instance_identifier}:${backup_id}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunKrdsBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsBackupCreate,
+		Read:   resourceKsyunKrdsBackupRead,
+		Delete: resourceKsyunKrdsBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKrdsBackup,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KRDS instance.",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the backup.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the backup.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the backup.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backup.",
+			},
+			"backup_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode of the backup.",
+			},
+			"backup_size": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The size of the backup, unit: MB.",
+			},
+			"backup_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"backup_create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup started.",
+			},
+			"backup_updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup finished.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsBackupCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.CreateKrdsBackup(d, resourceKsyunKrdsBackup())
+	if err != nil {
+		return fmt.Errorf("error on creating krds backup: %s", err)
+	}
+	return resourceKsyunKrdsBackupRead(d, meta)
+}
+
+func resourceKsyunKrdsBackupRead(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ReadAndSetKrdsBackup(d, resourceKsyunKrdsBackup())
+	if err != nil {
+		return fmt.Errorf("error on reading krds backup %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsBackupDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.RemoveKrdsBackup(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting krds backup %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,155 @@
+/*
+Provides the automated backup policy of the KRDS instance.
+
+**Note** The backup policy can not be removed from the instance, destroying this resource only removes it from the state.
+The backup time window is owned by `preferred_backup_time` of `ksyun_krds`, this resource only exports it.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_krds_backup_policy" "foo" {
+	  db_instance_identifier    = ksyun_krds.default.id
+	  preferred_backup_weekdays = ["Monday", "Wednesday", "Friday"]
+	  backup_retention_period   = 14
+	  binlog_retention_period   = 7
+
+	  cross_region_backup_enabled          = true
+	  cross_region_backup_region           = "cn-shanghai-2"
+	  cross_region_backup_retention_period = 30
+	}
+
+```
+
+# Import
+
+KRDS backup policy can be imported using the `db_instance_identifier`, e.g.
+
+```
+$ terraform import ksyun_krds_backup_policy.foo 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKrdsBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsBackupPolicyCreate,
+		Read:   resourceKsyunKrdsBackupPolicyRead,
+		Update: resourceKsyunKrdsBackupPolicyUpdate,
+		Delete: resourceKsyunKrdsBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: krdsInstanceCustomizeDiff(checkKrdsBackupPolicyDiff),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KRDS instance.",
+			},
+			"preferred_backup_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time window of the automated backup, it is managed by `preferred_backup_time` of `ksyun_krds`.",
+			},
+			"preferred_backup_weekdays": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Monday",
+						"Tuesday",
+						"Wednesday",
+						"Thursday",
+						"Friday",
+						"Saturday",
+						"Sunday",
+					}, false),
+				},
+				Set:         schema.HashString,
+				Description: "The weekdays of the automated backup, at least 2 days. Valid values: `Monday`, `Tuesday`, `Wednesday`, `Thursday`, `Friday`, `Saturday`, `Sunday`.",
+			},
+			"backup_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 730),
+				Description:  "The retention days of the automated backups. Value range: [7, 730].",
+			},
+			"binlog_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 730),
+				Description:  "The retention days of the binlogs, which can not be greater than `backup_retention_period`. Value range: [1, 730].",
+			},
+			"cross_region_backup_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to copy the backups to another region. Default is `false`.",
+			},
+			"cross_region_backup_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region which the backups are copied to. Required when `cross_region_backup_enabled` is `true`.",
+			},
+			"cross_region_backup_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1825),
+				Description:  "The retention days of the backups in the other region. Value range: [1, 1825].",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsBackupPolicyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ModifyKrdsBackupPolicy(d, resourceKsyunKrdsBackupPolicy())
+	if err != nil {
+		return fmt.Errorf("error on creating krds backup policy: %s", err)
+	}
+	return resourceKsyunKrdsBackupPolicyRead(d, meta)
+}
+
+func resourceKsyunKrdsBackupPolicyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ReadAndSetKrdsBackupPolicy(d, resourceKsyunKrdsBackupPolicy())
+	if err != nil {
+		return fmt.Errorf("error on reading krds backup policy %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ModifyKrdsBackupPolicy(d, resourceKsyunKrdsBackupPolicy())
+	if err != nil {
+		return fmt.Errorf("error on updating krds backup policy %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKrdsBackupPolicyRead(d, meta)
+}
+
+func resourceKsyunKrdsBackupPolicyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	d.SetId("")
+	return err
+}
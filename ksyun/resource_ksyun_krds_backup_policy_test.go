@@ -0,0 +1,57 @@
+package ksyun
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunKrdsBackupPolicy_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_krds_backup_policy.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsBackupPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_krds_backup_policy.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "backup_retention_period", "14"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "preferred_backup_weekdays.#", "3"),
+				),
+			},
+			{
+				Config:      testAccKrdsBackupPolicyInvalidConfig,
+				ExpectError: regexp.MustCompile("binlog_retention_period 30 can not be greater than backup_retention_period 14"),
+			},
+			{
+				ResourceName:      "ksyun_krds_backup_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccKrdsBackupPolicyConfig = testAccKrdsAccountBase + `
+resource "ksyun_krds_backup_policy" "foo" {
+  db_instance_identifier    = ksyun_krds.default.id
+  preferred_backup_weekdays = ["Monday", "Wednesday", "Friday"]
+  backup_retention_period   = 14
+  binlog_retention_period   = 7
+}
+`
+
+const testAccKrdsBackupPolicyInvalidConfig = testAccKrdsAccountBase + `
+resource "ksyun_krds_backup_policy" "foo" {
+  db_instance_identifier    = ksyun_krds.default.id
+  preferred_backup_weekdays = ["Monday", "Wednesday", "Friday"]
+  backup_retention_period   = 14
+  binlog_retention_period   = 30
+}
+`
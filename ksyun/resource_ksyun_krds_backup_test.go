@@ -0,0 +1,53 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunKrdsBackup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_krds_backup.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsBackupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_krds_backup.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup.foo", "backup_status", "COMPLETED"),
+					testAccCheckIDExists("ksyun_krds.restored"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKrdsBackupConfig = testAccKrdsAccountBase + `
+resource "ksyun_krds_backup" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  backup_name            = "tf-acc-krds-backup"
+}
+
+resource "ksyun_krds" "restored" {
+  db_instance_class    = "db.ram.2|db.disk.50"
+  db_instance_name     = "tf-acc-krds-restored"
+  db_instance_type     = "HRDS"
+  engine               = "mysql"
+  engine_version       = "5.7"
+  master_user_name     = "admin"
+  master_user_password = "123qweASD123"
+  vpc_id               = ksyun_vpc.default.id
+  subnet_id            = ksyun_subnet.default.id
+  bill_type            = "DAY"
+  restore_from {
+    source_db_instance_identifier = ksyun_krds.default.id
+    backup_id                     = ksyun_krds_backup.foo.id
+  }
+}
+`
@@ -0,0 +1,132 @@
+/*
+Provides a database resource of the KRDS instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_krds_database" "foo" {
+	  db_instance_identifier = ksyun_krds.default.id
+	  database_name          = "tf_database"
+	  character_set          = "utf8mb4"
+	  collation              = "utf8mb4_general_ci"
+	  description            = "created by terraform"
+	}
+
+```
+
+# Import
+
+KRDS database can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_krds_database.foo ${db_instance_identifier}:${database_name}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKrdsDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsDatabaseCreate,
+		Read:   resourceKsyunKrdsDatabaseRead,
+		Update: resourceKsyunKrdsDatabaseUpdate,
+		Delete: resourceKsyunKrdsDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "db_instance_identifier", "database_name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KRDS instance.",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database.",
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "utf8",
+				ValidateFunc: validation.StringInSlice([]string{
+					"utf8",
+					"gbk",
+					"latin1",
+					"utf8mb4",
+				}, false),
+				Description: "The character set of the database. Valid values: `utf8`, `gbk`, `latin1`, `utf8mb4`. Default is `utf8`.",
+			},
+			"collation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The collation of the database, such as `utf8mb4_general_ci`. If not set, the default collation of the character set is used.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the database.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the database.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsDatabaseCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.CreateKrdsDatabase(d, resourceKsyunKrdsDatabase())
+	if err != nil {
+		return fmt.Errorf("error on creating krds database: %s", err)
+	}
+	return resourceKsyunKrdsDatabaseRead(d, meta)
+}
+
+func resourceKsyunKrdsDatabaseRead(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ReadAndSetKrdsDatabase(d, resourceKsyunKrdsDatabase())
+	if err != nil {
+		return fmt.Errorf("error on reading krds database %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsDatabaseUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ModifyKrdsDatabase(d, resourceKsyunKrdsDatabase())
+	if err != nil {
+		return fmt.Errorf("error on updating krds database %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKrdsDatabaseRead(d, meta)
+}
+
+func resourceKsyunKrdsDatabaseDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.RemoveKrdsDatabase(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting krds database %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,211 @@
+/*
+Provides a read-only proxy of the KRDS instance. The proxy provides a single endpoint for the read-only instances,
+and the read requests are distributed to the read-only instances by weight.
+
+The read-only instance whose replication delay exceeds `delay_threshold` is removed from the proxy automatically
+when `auto_remove_delayed` is true, and it will be added back after the delay recovered.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_krds_rr" "rr1" {
+	  db_instance_identifier = ksyun_krds.default.id
+	  db_instance_class      = "db.ram.2|db.disk.50"
+	  db_instance_name       = "tf-krds-rr-1"
+	}
+
+	resource "ksyun_krds_rr" "rr2" {
+	  db_instance_identifier = ksyun_krds.default.id
+	  db_instance_class      = "db.ram.2|db.disk.50"
+	  db_instance_name       = "tf-krds-rr-2"
+	}
+
+	resource "ksyun_krds_proxy" "foo" {
+	  db_instance_identifier = ksyun_krds.default.id
+	  proxy_name             = "tf-krds-proxy"
+	  delay_threshold        = 30
+	  auto_remove_delayed    = true
+	  least_replicas         = 1
+	  read_only_instances {
+	    db_instance_identifier = ksyun_krds_rr.rr1.id
+	    weight                 = 100
+	  }
+	  read_only_instances {
+	    db_instance_identifier = ksyun_krds_rr.rr2.id
+	    weight                 = 50
+	  }
+	}
+
+	output "read_only_endpoint" {
+	  value = "${ksyun_krds_proxy.foo.vip}:${ksyun_krds_proxy.foo.port}"
+	}
+
+```
+
+# Import
+
+KRDS proxy can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_krds_proxy.foo ${db_instance_identifier}:${proxy_id}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunKrdsProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsProxyCreate,
+		Read:   resourceKsyunKrdsProxyRead,
+		Update: resourceKsyunKrdsProxyUpdate,
+		Delete: resourceKsyunKrdsProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKrdsProxy,
+		},
+		CustomizeDiff: krdsProxyCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the primary KRDS instance.",
+			},
+			"proxy_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the proxy.",
+			},
+			"read_only_instances": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         krdsProxyInstanceHash,
+				Description: "The read-only instances behind the proxy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_instance_identifier": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the read-only instance.",
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  "The weight of the read-only instance. Value range: [0, 100]. Default is 100. The instance with 0 weight receives no read requests.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the read-only instance in the proxy, the delayed instance is `REMOVED` temporarily.",
+						},
+					},
+				},
+			},
+			"delay_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 7200),
+				Description:  "The replication delay threshold of the read-only instances, unit: second. Default is 30.",
+			},
+			"auto_remove_delayed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to remove the read-only instance whose delay exceeds `delay_threshold` automatically. Default is true.",
+			},
+			"least_replicas": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The least number of read-only instances to keep when removing the delayed instances. Default is 1.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual IP of the proxy.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port of the proxy.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the proxy.",
+			},
+		},
+	}
+}
+
+// krdsProxyInstanceHash ignores the computed status, so that removing a delayed instance does not make a diff.
+func krdsProxyInstanceHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%v|%v", m["db_instance_identifier"], m["weight"]))
+}
+
+func krdsProxyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if !diff.NewValueKnown("read_only_instances") {
+		return err
+	}
+	instances := diff.Get("read_only_instances").(*schema.Set)
+	if least := diff.Get("least_replicas").(int); least > instances.Len() {
+		return fmt.Errorf("least_replicas %d can not be greater than the number of read_only_instances %d", least, instances.Len())
+	}
+	return err
+}
+
+func resourceKsyunKrdsProxyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.CreateKrdsProxy(d, resourceKsyunKrdsProxy())
+	if err != nil {
+		return fmt.Errorf("error on creating krds proxy: %s", err)
+	}
+	return resourceKsyunKrdsProxyRead(d, meta)
+}
+
+func resourceKsyunKrdsProxyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ReadAndSetKrdsProxy(d, resourceKsyunKrdsProxy())
+	if err != nil {
+		return fmt.Errorf("error on reading krds proxy %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsProxyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.ModifyKrdsProxy(d, resourceKsyunKrdsProxy())
+	if err != nil {
+		return fmt.Errorf("error on updating krds proxy %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKrdsProxyRead(d, meta)
+}
+
+func resourceKsyunKrdsProxyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	krdsService := KrdsService{meta.(*KsyunClient)}
+	err = krdsService.RemoveKrdsProxy(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting krds proxy %q, %s", d.Id(), err)
+	}
+	return err
+}
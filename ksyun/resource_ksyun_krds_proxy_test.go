@@ -0,0 +1,81 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunKrdsProxy_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_krds_proxy.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsProxyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_krds_proxy.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_proxy.foo", "read_only_instances.#", "1"),
+					resource.TestCheckResourceAttrSet("ksyun_krds_proxy.foo", "vip"),
+				),
+			},
+			{
+				Config: testAccKrdsProxyUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_krds_proxy.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_proxy.foo", "read_only_instances.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_krds_proxy.foo", "delay_threshold", "60"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKrdsProxyBase = testAccKrdsAccountBase + `
+resource "ksyun_krds_rr" "rr1" {
+  db_instance_identifier = ksyun_krds.default.id
+  db_instance_class      = "db.ram.2|db.disk.50"
+  db_instance_name       = "tf-acc-krds-rr-1"
+  bill_type              = "DAY"
+}
+
+resource "ksyun_krds_rr" "rr2" {
+  db_instance_identifier = ksyun_krds.default.id
+  db_instance_class      = "db.ram.2|db.disk.50"
+  db_instance_name       = "tf-acc-krds-rr-2"
+  bill_type              = "DAY"
+}
+`
+
+const testAccKrdsProxyConfig = testAccKrdsProxyBase + `
+resource "ksyun_krds_proxy" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  proxy_name             = "tf-acc-krds-proxy"
+  read_only_instances {
+    db_instance_identifier = ksyun_krds_rr.rr1.id
+    weight                 = 100
+  }
+}
+`
+
+const testAccKrdsProxyUpdateConfig = testAccKrdsProxyBase + `
+resource "ksyun_krds_proxy" "foo" {
+  db_instance_identifier = ksyun_krds.default.id
+  proxy_name             = "tf-acc-krds-proxy"
+  delay_threshold        = 60
+  least_replicas         = 1
+  read_only_instances {
+    db_instance_identifier = ksyun_krds_rr.rr1.id
+    weight                 = 100
+  }
+  read_only_instances {
+    db_instance_identifier = ksyun_krds_rr.rr2.id
+    weight                 = 50
+  }
+}
+`
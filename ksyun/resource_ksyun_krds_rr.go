@@ -56,6 +56,7 @@ var krdsRrNotSupport = []string{
 	"availability_zone_1",
 	"db_instance_class",
 	"db_parameter_template_id",
+	"restore_from",
 }
 
 func resourceKsyunKrdsRr() *schema.Resource {
@@ -141,6 +141,19 @@ resource "ksyun_ks3_bucket" "bucket-create2" {
   }
 }
 
+resource "ksyun_kms_key" "bucket" {
+  alias = "tf-bucket-key"
+}
+
+resource "ksyun_ks3_bucket" "bucket-encrypted" {
+  bucket = "bucket-20240206-encrypted"
+  #服务端加密
+  server_side_encryption_rule {
+    sse_algorithm     = "KMS"
+    kms_master_key_id = ksyun_kms_key.bucket.id
+  }
+}
+
 ```
 */
 
@@ -416,6 +429,28 @@ func resourceKsyunKs3Bucket() *schema.Resource {
 				Optional:    true,
 				Description: "Bucket Policy is an authorization policy for Bucket introduced by KS3. You can authorize other users to access the KS3 resources you specify through the space policy. If you want to turn off this setting, just leave it blank in the configuration.",
 			},
+
+			"server_side_encryption_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The default server-side encryption of the objects in the bucket. If you want to turn off this setting, just leave it blank in the configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"AES256", "KMS"}, false),
+							Description:  "The algorithm of the server-side encryption. Valid values: `AES256`, `KMS`.",
+						},
+						"kms_master_key_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The id of the `ksyun_kms_key` which encrypts the objects. It's only valid when `sse_algorithm` is `KMS`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -657,6 +692,25 @@ func resourceKsyunKs3BucketRead(d *schema.ResourceData, meta interface{}) error
 		return WrapError(err)
 	}
 
+	// Read the server-side encryption configuration
+	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		return ks3Client.GetBucketEncryption(d.Id())
+	})
+	if err != nil && !ks3NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketEncryption", KsyunKs3GoSdk)
+	}
+	addDebug("GetBucketEncryption", raw, requestInfo, request)
+	sseRules := make([]map[string]interface{}, 0)
+	if encryption, ok := raw.(ks3.GetBucketEncryptionResult); ok && err == nil && encryption.SSEDefault.SSEAlgorithm != "" {
+		sseRules = append(sseRules, map[string]interface{}{
+			"sse_algorithm":     encryption.SSEDefault.SSEAlgorithm,
+			"kms_master_key_id": encryption.SSEDefault.KMSMasterKeyID,
+		})
+	}
+	if err := d.Set("server_side_encryption_rule", sseRules); err != nil {
+		return WrapError(err)
+	}
+
 	return nil
 }
 
@@ -706,6 +760,13 @@ func resourceKsyunKs3BucketUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("policy")
 	}
 
+	if d.HasChange("server_side_encryption_rule") {
+		if err := resourceKsyunKs3BucketEncryptionUpdate(client, d); err != nil {
+			return WrapError(err)
+		}
+		d.SetPartial("server_side_encryption_rule")
+	}
+
 	d.Partial(false)
 	return resourceKsyunKs3BucketRead(d, meta)
 }
@@ -973,6 +1034,44 @@ func resourceKsyunKs3BucketPolicyUpdate(client *KsyunClient, d *schema.ResourceD
 	return nil
 }
 
+func resourceKsyunKs3BucketEncryptionUpdate(client *KsyunClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	rules := d.Get("server_side_encryption_rule").([]interface{})
+	var requestInfo *ks3.Client
+	if len(rules) == 0 || rules[0] == nil {
+		raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+			requestInfo = ks3Client
+			return nil, ks3Client.DeleteBucketEncryption(bucket)
+		})
+		if err != nil && !ks3NotFoundError(err) {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketEncryption", KsyunKs3GoSdk)
+		}
+		addDebug("DeleteBucketEncryption", raw, requestInfo, map[string]string{"bucketName": bucket})
+		return nil
+	}
+	rule := rules[0].(map[string]interface{})
+	encryption := ks3.ServerEncryptionRule{
+		SSEDefault: ks3.SSEDefaultRule{
+			SSEAlgorithm: rule["sse_algorithm"].(string),
+		},
+	}
+	if encryption.SSEDefault.SSEAlgorithm == "KMS" {
+		encryption.SSEDefault.KMSMasterKeyID = rule["kms_master_key_id"].(string)
+	}
+	raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		requestInfo = ks3Client
+		return nil, ks3Client.SetBucketEncryption(bucket, encryption)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "SetBucketEncryption", KsyunKs3GoSdk)
+	}
+	addDebug("SetBucketEncryption", raw, requestInfo, map[string]interface{}{
+		"bucketName":     bucket,
+		"encryptionRule": encryption,
+	})
+	return nil
+}
+
 func resourceKsyunKs3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*KsyunClient)
 	var requestInfo *ks3.Client
@@ -23,6 +23,61 @@ func TestAccKsyunKS3ResourceCreate(t *testing.T) {
 	})
 }
 
+func TestAccKsyunKS3Resource_encryption(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKS3BucketEncryptionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_ks3_bucket.bucket-encrypted"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.bucket-encrypted", "server_side_encryption_rule.0.sse_algorithm", "KMS"),
+				),
+			},
+			{
+				Config: testAccKS3BucketEncryptionUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.bucket-encrypted", "server_side_encryption_rule.0.sse_algorithm", "AES256"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKS3BucketEncryptionConfig = `
+provider "ksyun" {
+  endpoint = "ks3-cn-beijing.ksyuncs.com"
+}
+
+resource "ksyun_kms_key" "key" {
+  alias = "tf-acc-bucket-key"
+}
+
+resource "ksyun_ks3_bucket" "bucket-encrypted" {
+  bucket = "bucket-tf-acc-encrypted"
+  server_side_encryption_rule {
+    sse_algorithm     = "KMS"
+    kms_master_key_id = ksyun_kms_key.key.id
+  }
+}
+`
+
+const testAccKS3BucketEncryptionUpdateConfig = `
+provider "ksyun" {
+  endpoint = "ks3-cn-beijing.ksyuncs.com"
+}
+
+resource "ksyun_ks3_bucket" "bucket-encrypted" {
+  bucket = "bucket-tf-acc-encrypted"
+  server_side_encryption_rule {
+    sse_algorithm = "AES256"
+  }
+}
+`
+
 const testAccKS3BucketConfig = `
 provider "ksyun" {
   #指定KS3服务的访问域名
@@ -0,0 +1,152 @@
+/*
+Provides a manual backup of the MongoDB instance, both replica set and sharded instances are supported.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_mongodb_backup" "default" {
+	  instance_id = ksyun_mongodb_instance.default.id
+	  backup_name = "tf-mongodb-backup"
+	}
+
+	resource "ksyun_mongodb_instance" "restored" {
+	  name              = "tf-mongodb-restored"
+	  instance_account  = "root"
+	  instance_password = "Shiwo1101"
+	  instance_class    = "1C2G"
+	  storage           = 5
+	  node_num          = 3
+	  vpc_id            = ksyun_vpc.default.id
+	  vnet_id           = ksyun_subnet.default.id
+	  db_version        = "3.6"
+	  pay_type          = "hourlyInstantSettlement"
+	  availability_zone = "cn-shanghai-2b"
+	  backup_id         = ksyun_mongodb_backup.default.id
+	}
+
+```
+
+# Import
+
+MongoDB backup can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_mongodb_backup.default ${instance_id}:${backup_id}
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunMongodbBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongodbBackupCreate,
+		Read:   resourceMongodbBackupRead,
+		Delete: resourceMongodbBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importMongodbBackup,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the MongoDB instance, either replica set or sharded.",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the backup.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the backup.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backup, manual or automatic.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The size of the backup.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the backup.",
+			},
+		},
+	}
+}
+
+func resourceMongodbBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createMongodbBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating mongodb backup: %s", err)
+	}
+	return resourceMongodbBackupRead(d, meta)
+}
+
+func resourceMongodbBackupRead(d *schema.ResourceData, meta interface{}) error {
+	item, err := readMongodbBackup(d, meta, "")
+	if err != nil {
+		if canNotFoundMongodbError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading mongodb backup %q, %s", d.Id(), err)
+	}
+	extra := map[string]SdkResponseMapping{
+		"SnapshotId": {
+			Field: "backup_id",
+		},
+		"Name": {
+			Field: "backup_name",
+		},
+		"Type": {
+			Field: "backup_type",
+		},
+		"Status": {
+			Field: "status",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+		"Size": {
+			Field: "size",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+	}
+	SdkResponseAutoResourceData(d, resourceKsyunMongodbBackup(), item, extra)
+	return nil
+}
+
+func resourceMongodbBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeMongodbBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on deleting mongodb backup %q, %s", d.Id(), err)
+	}
+	return nil
+}
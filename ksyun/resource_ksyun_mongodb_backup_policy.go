@@ -0,0 +1,115 @@
+/*
+Provides the automated backup policy of the MongoDB instance, both replica set and sharded instances are supported.
+
+**Note** The backup policy can not be removed from the instance, destroying this resource only removes it from the state.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_mongodb_backup_policy" "default" {
+	  instance_id   = ksyun_mongodb_instance.default.id
+	  timing_switch = "on"
+	  timezone      = "02:00-03:00"
+	  time_cycle    = "Monday,Wednesday,Friday"
+	}
+
+```
+
+# Import
+
+MongoDB backup policy can be imported using the `instance_id`, e.g.
+
+```
+$ terraform import ksyun_mongodb_backup_policy.default 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunMongodbBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongodbBackupPolicyCreate,
+		Read:   resourceMongodbBackupPolicyRead,
+		Update: resourceMongodbBackupPolicyUpdate,
+		Delete: resourceMongodbBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importMongodbBackupPolicy,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the MongoDB instance, either replica set or sharded.",
+			},
+			"timing_switch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "on",
+				ValidateFunc: validation.StringInSlice([]string{
+					"on",
+					"off",
+				}, false),
+				Description: "Whether to enable the automated backup. Valid values: `on`, `off`. Default is `on`.",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The time window of the automated backup, such as `02:00-03:00`.",
+			},
+			"time_cycle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The weekdays of the automated backup, separated by commas, such as `Monday,Wednesday,Friday`.",
+			},
+		},
+	}
+}
+
+func resourceMongodbBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	err := modifyMongodbBackupPolicy(d, meta, resourceKsyunMongodbBackupPolicy())
+	if err != nil {
+		return fmt.Errorf("error on creating mongodb backup policy: %s", err)
+	}
+	d.SetId(d.Get("instance_id").(string))
+	return resourceMongodbBackupPolicyRead(d, meta)
+}
+
+func resourceMongodbBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	err := readMongodbBackupPolicy(d, meta, resourceKsyunMongodbBackupPolicy())
+	if err != nil {
+		if canNotFoundMongodbError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading mongodb backup policy %q, %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceMongodbBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := modifyMongodbBackupPolicy(d, meta, resourceKsyunMongodbBackupPolicy())
+	if err != nil {
+		return fmt.Errorf("error on updating mongodb backup policy %q, %s", d.Id(), err)
+	}
+	return resourceMongodbBackupPolicyRead(d, meta)
+}
+
+func resourceMongodbBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
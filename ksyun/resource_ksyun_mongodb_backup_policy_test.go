@@ -0,0 +1,63 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunMongodbBackupPolicy_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_mongodb_backup_policy.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongodbBackupPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_mongodb_backup_policy.default", "timing_switch", "on"),
+					resource.TestCheckResourceAttr("ksyun_mongodb_backup_policy.default", "timezone", "02:00-03:00"),
+					resource.TestCheckResourceAttr("ksyun_mongodb_backup_policy.shard", "time_cycle", "Monday,Thursday"),
+				),
+			},
+			{
+				Config: testAccMongodbBackupPolicyUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_mongodb_backup_policy.default", "timezone", "04:00-05:00"),
+					resource.TestCheckResourceAttr("ksyun_mongodb_backup_policy.shard", "timing_switch", "off"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMongodbBackupPolicyConfig = testAccMongodbBase + `
+resource "ksyun_mongodb_backup_policy" "default" {
+  instance_id = ksyun_mongodb_instance.default.id
+  timezone    = "02:00-03:00"
+  time_cycle  = "Monday,Wednesday,Friday"
+}
+
+resource "ksyun_mongodb_backup_policy" "shard" {
+  instance_id = ksyun_mongodb_shard_instance.default.id
+  timezone    = "02:00-03:00"
+  time_cycle  = "Monday,Thursday"
+}
+`
+
+const testAccMongodbBackupPolicyUpdateConfig = testAccMongodbBase + `
+resource "ksyun_mongodb_backup_policy" "default" {
+  instance_id = ksyun_mongodb_instance.default.id
+  timezone    = "04:00-05:00"
+  time_cycle  = "Monday,Wednesday,Friday"
+}
+
+resource "ksyun_mongodb_backup_policy" "shard" {
+  instance_id   = ksyun_mongodb_shard_instance.default.id
+  timing_switch = "off"
+  timezone      = "02:00-03:00"
+  time_cycle    = "Monday,Thursday"
+}
+`
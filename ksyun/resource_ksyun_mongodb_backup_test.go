@@ -0,0 +1,131 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunMongodbBackup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_mongodb_backup.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongodbBackupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_mongodb_backup.default"),
+					testAccCheckIDExists("ksyun_mongodb_backup.shard"),
+					testAccCheckMongodbInstanceExists("ksyun_mongodb_instance.restored"),
+					testAccCheckMongodbShardInstanceExists("ksyun_mongodb_shard_instance.restored"),
+					resource.TestCheckResourceAttrPair("ksyun_mongodb_shard_instance.restored", "backup_id", "ksyun_mongodb_backup.shard", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMongodbBase = `
+provider "ksyun" {
+  region = "cn-shanghai-2"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-mongodb-vpc"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-mongodb-subnet"
+  cidr_block        = "10.1.0.0/21"
+  subnet_type       = "Reserve"
+  dhcp_ip_from      = "10.1.0.2"
+  dhcp_ip_to        = "10.1.7.253"
+  vpc_id            = ksyun_vpc.default.id
+  gateway_ip        = "10.1.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-shanghai-2b"
+}
+
+resource "ksyun_mongodb_instance" "default" {
+  name              = "tf-acc-mongodb-repset"
+  instance_account  = "root"
+  instance_password = "Shiwo1101"
+  instance_class    = "1C2G"
+  storage           = 5
+  node_num          = 3
+  vpc_id            = ksyun_vpc.default.id
+  vnet_id           = ksyun_subnet.default.id
+  db_version        = "3.6"
+  pay_type          = "hourlyInstantSettlement"
+  iam_project_id    = "0"
+  availability_zone = "cn-shanghai-2b"
+}
+
+resource "ksyun_mongodb_shard_instance" "default" {
+  name              = "tf-acc-mongodb-shard"
+  instance_account  = "root"
+  instance_password = "Shiwo1101"
+  mongos_class      = "1C2G"
+  mongos_num        = 2
+  shard_class       = "1C2G"
+  shard_num         = 2
+  storage           = 5
+  vpc_id            = ksyun_vpc.default.id
+  vnet_id           = ksyun_subnet.default.id
+  db_version        = "3.6"
+  pay_type          = "hourlyInstantSettlement"
+  iam_project_id    = "0"
+  availability_zone = "cn-shanghai-2b"
+}
+`
+
+const testAccMongodbBackupConfig = testAccMongodbBase + `
+resource "ksyun_mongodb_backup" "default" {
+  instance_id = ksyun_mongodb_instance.default.id
+  backup_name = "tf-acc-mongodb-backup"
+}
+
+resource "ksyun_mongodb_backup" "shard" {
+  instance_id = ksyun_mongodb_shard_instance.default.id
+  backup_name = "tf-acc-mongodb-shard-backup"
+}
+
+resource "ksyun_mongodb_instance" "restored" {
+  name              = "tf-acc-mongodb-restored"
+  instance_account  = "root"
+  instance_password = "Shiwo1101"
+  instance_class    = "1C2G"
+  storage           = 5
+  node_num          = 3
+  vpc_id            = ksyun_vpc.default.id
+  vnet_id           = ksyun_subnet.default.id
+  db_version        = "3.6"
+  pay_type          = "hourlyInstantSettlement"
+  iam_project_id    = "0"
+  availability_zone = "cn-shanghai-2b"
+  backup_id         = ksyun_mongodb_backup.default.id
+}
+
+resource "ksyun_mongodb_shard_instance" "restored" {
+  name              = "tf-acc-mongodb-shard-restored"
+  instance_account  = "root"
+  instance_password = "Shiwo1101"
+  mongos_class      = "1C2G"
+  mongos_num        = 2
+  shard_class       = "1C2G"
+  shard_num         = 2
+  storage           = 5
+  vpc_id            = ksyun_vpc.default.id
+  vnet_id           = ksyun_subnet.default.id
+  db_version        = "3.6"
+  pay_type          = "hourlyInstantSettlement"
+  iam_project_id    = "0"
+  availability_zone = "cn-shanghai-2b"
+  backup_id         = ksyun_mongodb_backup.shard.id
+}
+`
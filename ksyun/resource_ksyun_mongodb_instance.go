@@ -248,6 +248,12 @@ func resourceKsyunMongodbInstance() *schema.Resource {
 				Computed:    true,
 				Description: "time cycle of backup.",
 			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the backup which the instance is restored from. The backup is restored to a new instance.",
+			},
 			"product_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
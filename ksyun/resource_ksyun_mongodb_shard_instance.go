@@ -1,3 +1,56 @@
+/*
+Provides a sharded MongoDB resource.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_mongodb_shard_instance" "default" {
+	  name = "mongodb_shard_tf"
+	  instance_account = "root"
+	  instance_password = "admin"
+	  mongos_class = "1C2G"
+	  mongos_num = 2
+	  shard_class = "1C2G"
+	  shard_num = 2
+	  storage = 5
+	  vpc_id = "VpcId"
+	  vnet_id = "VnetId"
+	  db_version = "3.6"
+	  pay_type = "hourlyInstantSettlement"
+	  iam_project_id = "0"
+	  availability_zone = "cn-shanghai-3b"
+	}
+
+	# restore a backup of a sharded instance to a new instance
+	resource "ksyun_mongodb_shard_instance" "restored" {
+	  name = "mongodb_shard_restored_tf"
+	  instance_account = "root"
+	  instance_password = "admin"
+	  mongos_class = "1C2G"
+	  mongos_num = 2
+	  shard_class = "1C2G"
+	  shard_num = 2
+	  storage = 5
+	  vpc_id = "VpcId"
+	  vnet_id = "VnetId"
+	  db_version = "3.6"
+	  pay_type = "hourlyInstantSettlement"
+	  iam_project_id = "0"
+	  availability_zone = "cn-shanghai-3b"
+	  backup_id = "BackupId"
+	}
+
+```
+
+# Import
+
+MongoDB can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_mongodb_shard_instance.default 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
 package ksyun
 
 import (
@@ -15,6 +68,7 @@ func resourceKsyunMongodbShardInstance() *schema.Resource {
 			Default:          3,
 			ValidateFunc:     validation.IntBetween(2, 32),
 			DiffSuppressFunc: mongodbShardInstanceSchemaDiffSuppressFunc(),
+			Description:      "The number of shards, valid values: 2-32.",
 		},
 		"mongos_num": {
 			Type:             schema.TypeInt,
@@ -22,6 +76,7 @@ func resourceKsyunMongodbShardInstance() *schema.Resource {
 			Default:          2,
 			ValidateFunc:     validation.IntBetween(2, 32),
 			DiffSuppressFunc: mongodbShardInstanceSchemaDiffSuppressFunc(),
+			Description:      "The number of mongos nodes, valid values: 2-32.",
 		},
 		"shard_class": {
 			Type:     schema.TypeString,
@@ -37,6 +92,7 @@ func resourceKsyunMongodbShardInstance() *schema.Resource {
 			}, false),
 			Default:          "1C2G",
 			DiffSuppressFunc: mongodbShardInstanceSchemaDiffSuppressFunc(),
+			Description:      "The class of the shard node.",
 		},
 		"mongos_class": {
 			Type:     schema.TypeString,
@@ -52,6 +108,7 @@ func resourceKsyunMongodbShardInstance() *schema.Resource {
 			}, false),
 			Default:          "1C2G",
 			DiffSuppressFunc: mongodbShardInstanceSchemaDiffSuppressFunc(),
+			Description:      "The class of the mongos node.",
 		},
 		"storage": {
 			Type:             schema.TypeInt,
@@ -59,6 +116,7 @@ func resourceKsyunMongodbShardInstance() *schema.Resource {
 			ValidateFunc:     validation.IntBetween(5, 1000),
 			Default:          50,
 			DiffSuppressFunc: mongodbShardInstanceSchemaDiffSuppressFunc(),
+			Description:      "The storage of each shard node in GB, valid values: 5-1000.",
 		},
 		"db_version": {
 			Type:     schema.TypeString,
@@ -67,24 +125,35 @@ func resourceKsyunMongodbShardInstance() *schema.Resource {
 				"3.2",
 				"3.6",
 			}, false),
-			Default:  "3.2",
-			ForceNew: true,
+			Default:     "3.2",
+			ForceNew:    true,
+			Description: "The version of instance engine, and support `3.2`, `3.6`, default is `3.2`.",
 		},
 		"node_num": {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of nodes.",
 		},
 		"total_storage": {
-			Type:     schema.TypeInt,
-			Computed: true,
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total storage of the instance in GB.",
 		},
 		"instance_class": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The class of the instance.",
 		},
 		"instance_type": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the instance.",
+		},
+		"backup_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The ID of the backup which the instance is restored from. The backup must be taken from a sharded instance, and it is restored to a new instance.",
 		},
 	}
 	for k, v := range instanceSchema {
@@ -0,0 +1,146 @@
+/*
+Provides a database user of the MongoDB instance, both replica set and sharded instances are supported.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_mongodb_user" "default" {
+	  instance_id = ksyun_mongodb_shard_instance.default.id
+	  database    = "admin"
+	  user_name   = "tf_user"
+	  password    = "Shiwo1101"
+	  roles {
+	    role = "readWrite"
+	    db   = "orders"
+	  }
+	  roles {
+	    role = "read"
+	    db   = "reports"
+	  }
+	}
+
+```
+
+# Import
+
+MongoDB user can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_mongodb_user.default ${instance_id}:${database}:${user_name}
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunMongodbUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMongodbUserCreate,
+		Read:   resourceMongodbUserRead,
+		Update: resourceMongodbUserUpdate,
+		Delete: resourceMongodbUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(3, "instance_id", "database", "user_name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the MongoDB instance, either replica set or sharded.",
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "admin",
+				Description: "The authentication database of the user. Default is `admin`.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the user.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the user.",
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The built-in role, such as `read`, `readWrite`, `dbAdmin`, `dbOwner`, `clusterMonitor`.",
+						},
+						"db": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The database which the role is granted on.",
+						},
+					},
+				},
+				Description: "The roles granted to the user.",
+			},
+		},
+	}
+}
+
+func resourceMongodbUserCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createMongodbUser(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating mongodb user: %s", err)
+	}
+	return resourceMongodbUserRead(d, meta)
+}
+
+func resourceMongodbUserRead(d *schema.ResourceData, meta interface{}) error {
+	item, err := readMongodbUser(d, meta)
+	if err != nil {
+		if canNotFoundMongodbError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading mongodb user %q, %s", d.Id(), err)
+	}
+	return d.Set("roles", mongodbUserRoles(item))
+}
+
+func resourceMongodbUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := modifyMongodbUser(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on updating mongodb user %q, %s", d.Id(), err)
+	}
+	return resourceMongodbUserRead(d, meta)
+}
+
+func resourceMongodbUserDelete(d *schema.ResourceData, meta interface{}) error {
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		err := removeMongodbUser(d, meta)
+		if err == nil {
+			return nil
+		}
+		_, readErr := readMongodbUser(d, meta)
+		if readErr != nil && canNotFoundMongodbError(readErr) {
+			return nil
+		}
+		return resource.RetryableError(err)
+	})
+}
@@ -0,0 +1,95 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunMongodbUser_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_mongodb_user.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongodbUserConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_mongodb_user.default"),
+					resource.TestCheckResourceAttr("ksyun_mongodb_user.default", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_mongodb_user.shard", "roles.#", "2"),
+				),
+			},
+			{
+				Config: testAccMongodbUserUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_mongodb_user.default", "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_mongodb_user.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+const testAccMongodbUserConfig = testAccMongodbBase + `
+resource "ksyun_mongodb_user" "default" {
+  instance_id = ksyun_mongodb_instance.default.id
+  user_name   = "tf_acc_user"
+  password    = "Shiwo1101"
+  roles {
+    role = "readWrite"
+    db   = "orders"
+  }
+}
+
+resource "ksyun_mongodb_user" "shard" {
+  instance_id = ksyun_mongodb_shard_instance.default.id
+  user_name   = "tf_acc_user"
+  password    = "Shiwo1101"
+  roles {
+    role = "readWrite"
+    db   = "orders"
+  }
+  roles {
+    role = "read"
+    db   = "reports"
+  }
+}
+`
+
+const testAccMongodbUserUpdateConfig = testAccMongodbBase + `
+resource "ksyun_mongodb_user" "default" {
+  instance_id = ksyun_mongodb_instance.default.id
+  user_name   = "tf_acc_user"
+  password    = "Shiwo1102"
+  roles {
+    role = "readWrite"
+    db   = "orders"
+  }
+  roles {
+    role = "dbAdmin"
+    db   = "orders"
+  }
+}
+
+resource "ksyun_mongodb_user" "shard" {
+  instance_id = ksyun_mongodb_shard_instance.default.id
+  user_name   = "tf_acc_user"
+  password    = "Shiwo1101"
+  roles {
+    role = "readWrite"
+    db   = "orders"
+  }
+  roles {
+    role = "read"
+    db   = "reports"
+  }
+}
+`
@@ -0,0 +1,116 @@
+/*
+Provides a binding from an exchange to a queue or another exchange inside the rabbitmq instance,
+managed by the management api of the instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_rabbitmq_binding" "default" {
+	  instance_id      = ksyun_rabbitmq_instance.default.id
+	  admin_user       = "root"
+	  admin_password   = "Shiwo1101"
+	  vhost            = ksyun_rabbitmq_vhost.default.name
+	  source           = ksyun_rabbitmq_exchange.default.name
+	  destination      = ksyun_rabbitmq_queue.default.name
+	  destination_type = "queue"
+	  routing_key      = "orders.created.#"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunRabbitmqBinding() *schema.Resource {
+	m := rabbitmqManagementSchema()
+	m["vhost"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "/",
+		Description: "The name of the vhost. Default is `/`.",
+	}
+	m["source"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the source exchange.",
+	}
+	m["destination"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the destination queue or exchange.",
+	}
+	m["destination_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"queue",
+			"exchange",
+		}, false),
+		Description: "The type of the destination. Valid values: `queue`, `exchange`.",
+	}
+	m["routing_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The routing key of the binding.",
+	}
+	m["arguments"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The optional arguments of the binding. Integer and boolean values are sent as numbers and booleans.",
+	}
+	m["properties_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The key which identifies the binding between the same source and destination.",
+	}
+	return &schema.Resource{
+		Create: resourceRabbitmqBindingCreate,
+		Read:   resourceRabbitmqBindingRead,
+		Update: resourceRabbitmqBindingRead,
+		Delete: resourceRabbitmqBindingDelete,
+		Schema: m,
+	}
+}
+
+func resourceRabbitmqBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRabbitmqBinding(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating rabbitmq binding: %s", err)
+	}
+	return resourceRabbitmqBindingRead(d, meta)
+}
+
+func resourceRabbitmqBindingRead(d *schema.ResourceData, meta interface{}) error {
+	data, err := readRabbitmqBinding(d, meta)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading rabbitmq binding %q, %s", d.Id(), err)
+	}
+	_ = d.Set("routing_key", data["routing_key"])
+	return d.Set("arguments", rabbitmqArgumentsResp(data["arguments"]))
+}
+
+func resourceRabbitmqBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRabbitmqBinding(d, meta)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting rabbitmq binding %q, %s", d.Id(), err)
+	}
+	return nil
+}
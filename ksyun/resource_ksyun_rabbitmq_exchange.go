@@ -0,0 +1,128 @@
+/*
+Provides an exchange inside the rabbitmq instance, managed by the management api of the instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_rabbitmq_exchange" "default" {
+	  instance_id    = ksyun_rabbitmq_instance.default.id
+	  admin_user     = "root"
+	  admin_password = "Shiwo1101"
+	  vhost          = ksyun_rabbitmq_vhost.default.name
+	  name           = "orders"
+	  type           = "topic"
+	  arguments = {
+	    "alternate-exchange" = "orders.unrouted"
+	  }
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunRabbitmqExchange() *schema.Resource {
+	m := rabbitmqManagementSchema()
+	m["vhost"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "/",
+		Description: "The name of the vhost. Default is `/`.",
+	}
+	m["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the exchange.",
+	}
+	m["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"direct",
+			"fanout",
+			"topic",
+			"headers",
+		}, false),
+		Description: "The type of the exchange. Valid values: `direct`, `fanout`, `topic`, `headers`.",
+	}
+	m["durable"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     true,
+		Description: "Whether the exchange survives a broker restart. Default is `true`.",
+	}
+	m["auto_delete"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Whether the exchange is deleted when the last binding is removed. Default is `false`.",
+	}
+	m["internal"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Whether the exchange can only be published by other exchanges. Default is `false`.",
+	}
+	m["arguments"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The optional arguments of the exchange. Integer and boolean values are sent as numbers and booleans.",
+	}
+	return &schema.Resource{
+		Create: resourceRabbitmqExchangeCreate,
+		Read:   resourceRabbitmqExchangeRead,
+		Update: resourceRabbitmqExchangeRead,
+		Delete: resourceRabbitmqExchangeDelete,
+		Schema: m,
+	}
+}
+
+func resourceRabbitmqExchangeCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRabbitmqExchange(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating rabbitmq exchange: %s", err)
+	}
+	return resourceRabbitmqExchangeRead(d, meta)
+}
+
+func resourceRabbitmqExchangeRead(d *schema.ResourceData, meta interface{}) error {
+	data, err := readRabbitmqExchange(d, meta)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading rabbitmq exchange %q, %s", d.Id(), err)
+	}
+	for _, k := range []string{"type", "durable", "auto_delete", "internal"} {
+		if v, ok := data[k]; ok {
+			if err = d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	return d.Set("arguments", rabbitmqArgumentsResp(data["arguments"]))
+}
+
+func resourceRabbitmqExchangeDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRabbitmqExchange(d, meta)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting rabbitmq exchange %q, %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Provides the permissions of a user on a vhost inside the rabbitmq instance, managed by the management api of the instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_rabbitmq_permission" "default" {
+	  instance_id    = ksyun_rabbitmq_instance.default.id
+	  admin_user     = "root"
+	  admin_password = "Shiwo1101"
+	  user           = ksyun_rabbitmq_user.default.name
+	  vhost          = ksyun_rabbitmq_vhost.default.name
+	  configure      = ".*"
+	  write          = ".*"
+	  read           = ".*"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunRabbitmqPermission() *schema.Resource {
+	m := rabbitmqManagementSchema()
+	m["user"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the user.",
+	}
+	m["vhost"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "/",
+		Description: "The name of the vhost. Default is `/`.",
+	}
+	m["configure"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The regular expression of the resources which the user can configure.",
+	}
+	m["write"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The regular expression of the resources which the user can write.",
+	}
+	m["read"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The regular expression of the resources which the user can read.",
+	}
+	return &schema.Resource{
+		Create: resourceRabbitmqPermissionCreate,
+		Read:   resourceRabbitmqPermissionRead,
+		Update: resourceRabbitmqPermissionUpdate,
+		Delete: resourceRabbitmqPermissionDelete,
+		Schema: m,
+	}
+}
+
+func resourceRabbitmqPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	err := putRabbitmqPermission(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating rabbitmq permission: %s", err)
+	}
+	return resourceRabbitmqPermissionRead(d, meta)
+}
+
+func resourceRabbitmqPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	data, err := readRabbitmqPermission(d, meta)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading rabbitmq permission %q, %s", d.Id(), err)
+	}
+	for _, k := range []string{"configure", "write", "read"} {
+		if err = d.Set(k, data[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceRabbitmqPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("configure") || d.HasChange("write") || d.HasChange("read") {
+		err := putRabbitmqPermission(d, meta)
+		if err != nil {
+			return fmt.Errorf("error on updating rabbitmq permission %q, %s", d.Id(), err)
+		}
+	}
+	return resourceRabbitmqPermissionRead(d, meta)
+}
+
+func resourceRabbitmqPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRabbitmqPermission(d, meta)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting rabbitmq permission %q, %s", d.Id(), err)
+	}
+	return nil
+}
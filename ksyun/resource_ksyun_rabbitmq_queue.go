@@ -0,0 +1,108 @@
+/*
+Provides a queue inside the rabbitmq instance, managed by the management api of the instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_rabbitmq_queue" "default" {
+	  instance_id    = ksyun_rabbitmq_instance.default.id
+	  admin_user     = "root"
+	  admin_password = "Shiwo1101"
+	  vhost          = ksyun_rabbitmq_vhost.default.name
+	  name           = "orders.created"
+	  arguments = {
+	    "x-message-ttl" = "60000"
+	    "x-queue-type"  = "classic"
+	  }
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunRabbitmqQueue() *schema.Resource {
+	m := rabbitmqManagementSchema()
+	m["vhost"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     "/",
+		Description: "The name of the vhost. Default is `/`.",
+	}
+	m["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the queue.",
+	}
+	m["durable"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     true,
+		Description: "Whether the queue survives a broker restart. Default is `true`.",
+	}
+	m["auto_delete"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "Whether the queue is deleted when the last consumer unsubscribes. Default is `false`.",
+	}
+	m["arguments"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The optional arguments of the queue, such as `x-message-ttl`. Integer and boolean values are sent as numbers and booleans.",
+	}
+	return &schema.Resource{
+		Create: resourceRabbitmqQueueCreate,
+		Read:   resourceRabbitmqQueueRead,
+		Update: resourceRabbitmqQueueRead,
+		Delete: resourceRabbitmqQueueDelete,
+		Schema: m,
+	}
+}
+
+func resourceRabbitmqQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRabbitmqQueue(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating rabbitmq queue: %s", err)
+	}
+	return resourceRabbitmqQueueRead(d, meta)
+}
+
+func resourceRabbitmqQueueRead(d *schema.ResourceData, meta interface{}) error {
+	data, err := readRabbitmqQueue(d, meta)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading rabbitmq queue %q, %s", d.Id(), err)
+	}
+	for _, k := range []string{"durable", "auto_delete"} {
+		if v, ok := data[k]; ok {
+			if err = d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+	return d.Set("arguments", rabbitmqArgumentsResp(data["arguments"]))
+}
+
+func resourceRabbitmqQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRabbitmqQueue(d, meta)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting rabbitmq queue %q, %s", d.Id(), err)
+	}
+	return nil
+}
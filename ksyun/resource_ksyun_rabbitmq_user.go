@@ -0,0 +1,94 @@
+/*
+Provides a user inside the rabbitmq instance, managed by the management api of the instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_rabbitmq_user" "default" {
+	  instance_id    = ksyun_rabbitmq_instance.default.id
+	  admin_user     = "root"
+	  admin_password = "Shiwo1101"
+	  name           = "orders-app"
+	  password       = "Shiwo1102"
+	  tags           = ["management"]
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunRabbitmqUser() *schema.Resource {
+	m := rabbitmqManagementSchema()
+	m["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the user.",
+	}
+	m["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The password of the user.",
+	}
+	m["tags"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         schema.HashString,
+		Description: "The tags of the user, such as `administrator`, `monitoring`, `management`, `policymaker`.",
+	}
+	return &schema.Resource{
+		Create: resourceRabbitmqUserCreate,
+		Read:   resourceRabbitmqUserRead,
+		Update: resourceRabbitmqUserUpdate,
+		Delete: resourceRabbitmqUserDelete,
+		Schema: m,
+	}
+}
+
+func resourceRabbitmqUserCreate(d *schema.ResourceData, meta interface{}) error {
+	err := putRabbitmqUser(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating rabbitmq user: %s", err)
+	}
+	return resourceRabbitmqUserRead(d, meta)
+}
+
+func resourceRabbitmqUserRead(d *schema.ResourceData, meta interface{}) error {
+	data, err := readRabbitmqUser(d, meta)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading rabbitmq user %q, %s", d.Id(), err)
+	}
+	_ = d.Set("name", data["name"])
+	return d.Set("tags", rabbitmqUserTags(data))
+}
+
+func resourceRabbitmqUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("password") || d.HasChange("tags") {
+		err := putRabbitmqUser(d, meta)
+		if err != nil {
+			return fmt.Errorf("error on updating rabbitmq user %q, %s", d.Id(), err)
+		}
+	}
+	return resourceRabbitmqUserRead(d, meta)
+}
+
+func resourceRabbitmqUserDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRabbitmqUser(d, meta)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting rabbitmq user %q, %s", d.Id(), err)
+	}
+	return nil
+}
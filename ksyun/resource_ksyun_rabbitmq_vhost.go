@@ -0,0 +1,87 @@
+/*
+Provides a virtual host inside the rabbitmq instance, managed by the management api of the instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_rabbitmq_vhost" "default" {
+	  instance_id    = ksyun_rabbitmq_instance.default.id
+	  admin_user     = "root"
+	  admin_password = "Shiwo1101"
+	  name           = "orders"
+	}
+
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunRabbitmqVhost() *schema.Resource {
+	m := rabbitmqManagementSchema()
+	m["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the vhost.",
+	}
+	m["tracing"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to enable the message tracing of the vhost.",
+	}
+	return &schema.Resource{
+		Create: resourceRabbitmqVhostCreate,
+		Read:   resourceRabbitmqVhostRead,
+		Update: resourceRabbitmqVhostUpdate,
+		Delete: resourceRabbitmqVhostDelete,
+		Schema: m,
+	}
+}
+
+func resourceRabbitmqVhostCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRabbitmqVhost(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating rabbitmq vhost: %s", err)
+	}
+	return resourceRabbitmqVhostRead(d, meta)
+}
+
+func resourceRabbitmqVhostRead(d *schema.ResourceData, meta interface{}) error {
+	data, err := readRabbitmqVhost(d, meta)
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading rabbitmq vhost %q, %s", d.Id(), err)
+	}
+	if v, ok := data["tracing"].(bool); ok {
+		_ = d.Set("tracing", v)
+	}
+	return d.Set("name", data["name"])
+}
+
+func resourceRabbitmqVhostUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("tracing") {
+		err := createRabbitmqVhost(d, meta)
+		if err != nil {
+			return fmt.Errorf("error on updating rabbitmq vhost %q, %s", d.Id(), err)
+		}
+	}
+	return resourceRabbitmqVhostRead(d, meta)
+}
+
+func resourceRabbitmqVhostDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRabbitmqVhost(d, meta)
+	if err != nil && !notFoundError(err) {
+		return fmt.Errorf("error on deleting rabbitmq vhost %q, %s", d.Id(), err)
+	}
+	return nil
+}
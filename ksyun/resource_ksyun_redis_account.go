@@ -0,0 +1,157 @@
+/*
+Provides an ACL account of the redis instance.
+
+**Note** ACL accounts are only supported by redis 6.0 and above.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_redis_account" "default" {
+	  cache_id         = ksyun_redis_instance.default.id
+	  account_name     = "tf_reader"
+	  account_password = "Shiwo1101"
+	  privilege        = "ReadOnly"
+	  key_patterns     = ["user:*", "order:*"]
+	  description      = "read only account"
+	}
+
+```
+
+# Import
+
+redis account can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_redis_account.default ${cache_id}:${account_name}
+```
+
+**Note** The `account_password` can not be read from the API, so it will be empty after import.
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceRedisAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRedisAccountCreate,
+		Read:   resourceRedisAccountRead,
+		Update: resourceRedisAccountUpdate,
+		Delete: resourceRedisAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "cache_id", "account_name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"available_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Zone of the redis instance.",
+			},
+			"cache_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the redis instance.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the account.",
+			},
+			"account_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the account. The password is a string of 8 to 30 characters and must contain uppercase letters, lowercase letters, and numbers.",
+			},
+			"privilege": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ReadWrite",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ReadOnly",
+					"ReadWrite",
+				}, false),
+				Description: "The privilege of the account. Valid values: ReadOnly, ReadWrite.",
+			},
+			"key_patterns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "The key patterns the account can access, such as `user:*`. All keys can be accessed if not set.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the account.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the account.",
+			},
+		},
+	}
+}
+
+func resourceRedisAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRedisAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating redis account: %s", err)
+	}
+	return resourceRedisAccountRead(d, meta)
+}
+
+func resourceRedisAccountRead(d *schema.ResourceData, meta interface{}) error {
+	item, err := readRedisAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading redis account %q, %s", d.Id(), err)
+	}
+	extra := map[string]SdkResponseMapping{
+		"keyPatterns": {
+			Field: "key_patterns",
+		},
+		"status": {
+			Field: "status",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+	}
+	SdkResponseAutoResourceData(d, resourceRedisAccount(), item, extra)
+	return nil
+}
+
+func resourceRedisAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := modifyRedisAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on updating redis account %q, %s", d.Id(), err)
+	}
+	return resourceRedisAccountRead(d, meta)
+}
+
+func resourceRedisAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRedisAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on deleting redis account %q, %s", d.Id(), err)
+	}
+	return nil
+}
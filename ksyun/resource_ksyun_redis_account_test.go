@@ -0,0 +1,90 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunRedisAccount_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_redis_account.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_redis_account.default"),
+					resource.TestCheckResourceAttr("ksyun_redis_account.default", "privilege", "ReadOnly"),
+					resource.TestCheckResourceAttr("ksyun_redis_account.default", "key_patterns.#", "2"),
+				),
+			},
+			{
+				Config: testAccRedisAccountUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_redis_account.default"),
+					resource.TestCheckResourceAttr("ksyun_redis_account.default", "privilege", "ReadWrite"),
+					resource.TestCheckResourceAttr("ksyun_redis_account.default", "key_patterns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRedisAccountInstance = `
+provider "ksyun" {
+  region = "cn-beijing-6"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-redis-vpc"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-redis-subnet"
+  cidr_block        = "10.1.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = ksyun_vpc.default.id
+  gateway_ip        = "10.1.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-beijing-6a"
+}
+
+resource "ksyun_redis_instance" "default" {
+  available_zone = "cn-beijing-6a"
+  name           = "tf-acc-redis-acl"
+  mode           = 2
+  capacity       = 1
+  vnet_id        = ksyun_subnet.default.id
+  vpc_id         = ksyun_vpc.default.id
+  pass_word      = "Shiwo1101"
+  protocol       = "6.0"
+}
+`
+
+const testAccRedisAccountConfig = testAccRedisAccountInstance + `
+resource "ksyun_redis_account" "default" {
+  available_zone   = "cn-beijing-6a"
+  cache_id         = ksyun_redis_instance.default.id
+  account_name     = "tf_reader"
+  account_password = "Shiwo1101"
+  privilege        = "ReadOnly"
+  key_patterns     = ["user:*", "order:*"]
+}
+`
+
+const testAccRedisAccountUpdateConfig = testAccRedisAccountInstance + `
+resource "ksyun_redis_account" "default" {
+  available_zone   = "cn-beijing-6a"
+  cache_id         = ksyun_redis_instance.default.id
+  account_name     = "tf_reader"
+  account_password = "Shiwo1102"
+  privilege        = "ReadWrite"
+  key_patterns     = ["user:*"]
+}
+`
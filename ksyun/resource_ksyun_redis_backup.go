@@ -0,0 +1,156 @@
+/*
+Provides a manual backup of the redis instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_redis_backup" "default" {
+	  cache_id    = ksyun_redis_instance.default.id
+	  backup_name = "tf-redis-backup"
+	}
+
+	resource "ksyun_redis_instance" "restored" {
+	  available_zone = "cn-beijing-6a"
+	  name           = "tf-redis-restored"
+	  mode           = 2
+	  capacity       = 1
+	  vnet_id        = ksyun_subnet.default.id
+	  vpc_id         = ksyun_vpc.default.id
+	  pass_word      = "Shiwo1101"
+	  protocol       = "4.0"
+	  backup_id      = ksyun_redis_backup.default.id
+	}
+
+```
+
+# Import
+
+redis backup can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_redis_backup.default ${cache_id}:${backup_id}
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceRedisBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRedisBackupCreate,
+		Read:   resourceRedisBackupRead,
+		Delete: resourceRedisBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importRedisBackup,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"available_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Zone of the redis instance.",
+			},
+			"cache_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the redis instance.",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the backup.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the backup.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backup, manual or automatic.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The size of the backup.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the backup.",
+			},
+		},
+	}
+}
+
+func resourceRedisBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRedisBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating redis backup: %s", err)
+	}
+	return resourceRedisBackupRead(d, meta)
+}
+
+func resourceRedisBackupRead(d *schema.ResourceData, meta interface{}) error {
+	item, err := readRedisBackup(d, meta, "")
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading redis backup %q, %s", d.Id(), err)
+	}
+	extra := map[string]SdkResponseMapping{
+		"snapshotId": {
+			Field: "backup_id",
+		},
+		"name": {
+			Field: "backup_name",
+		},
+		"snapshotType": {
+			Field: "backup_type",
+		},
+		"status": {
+			Field: "status",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+		"size": {
+			Field: "size",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+	}
+	SdkResponseAutoResourceData(d, resourceRedisBackup(), item, extra)
+	return nil
+}
+
+func resourceRedisBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRedisBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on deleting redis backup %q, %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunRedisBackup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_redis_backup.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisBackupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_redis_backup.default"),
+					testAccCheckKcsInstanceExists("ksyun_redis_instance.restored"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRedisBase = `
+provider "ksyun" {
+  region = "cn-beijing-6"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-redis-vpc"
+  cidr_block = "10.1.0.0/21"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-redis-subnet"
+  cidr_block        = "10.1.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = ksyun_vpc.default.id
+  gateway_ip        = "10.1.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-beijing-6a"
+}
+
+resource "ksyun_redis_instance" "default" {
+  available_zone = "cn-beijing-6a"
+  name           = "tf-acc-redis"
+  mode           = 2
+  capacity       = 1
+  vnet_id        = ksyun_subnet.default.id
+  vpc_id         = ksyun_vpc.default.id
+  pass_word      = "Shiwo1101"
+  protocol       = "4.0"
+}
+`
+
+const testAccRedisBackupConfig = testAccRedisBase + `
+resource "ksyun_redis_backup" "default" {
+  available_zone = "cn-beijing-6a"
+  cache_id       = ksyun_redis_instance.default.id
+  backup_name    = "tf-acc-redis-backup"
+}
+
+resource "ksyun_redis_instance" "restored" {
+  available_zone = "cn-beijing-6a"
+  name           = "tf-acc-redis-restored"
+  mode           = 2
+  capacity       = 1
+  vnet_id        = ksyun_subnet.default.id
+  vpc_id         = ksyun_vpc.default.id
+  pass_word      = "Shiwo1101"
+  protocol       = "4.0"
+  backup_id      = ksyun_redis_backup.default.id
+}
+`
@@ -115,10 +115,11 @@ import (
 // instance
 func resourceRedisInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRedisInstanceCreate,
-		Delete: resourceRedisInstanceDelete,
-		Update: resourceRedisInstanceUpdate,
-		Read:   resourceRedisInstanceRead,
+		Create:        resourceRedisInstanceCreate,
+		Delete:        resourceRedisInstanceDelete,
+		Update:        resourceRedisInstanceUpdate,
+		Read:          resourceRedisInstanceRead,
+		CustomizeDiff: redisInstanceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -234,6 +235,17 @@ func resourceRedisInstance() *schema.Resource {
 				Default:     false,
 				Description: "whether reset all parameters.",
 			},
+			"parameter_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the redis parameter group applied to the instance. The `parameters` of the instance override the values of the group. When the group is changed, the new values are applied on the next update of the instance.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the redis backup to restore the new instance from.",
+			},
 			"parameters": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -282,13 +294,13 @@ func resourceRedisInstance() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 1024),
-				Description:  "each shard mem size GB.",
+				Description:  "each shard mem size GB. Changing it of the SelfDefineCluster(mode=3) reshards the instance online, and the update waits until the instance is running again.",
 			},
 			"shard_num": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 1024),
-				Description:  "shard number.",
+				Description:  "shard number. Changing it of the SelfDefineCluster(mode=3) reshards the instance online, and the update waits until the instance is running again.",
 			},
 			"prepare_az_name": {
 				Type:        schema.TypeString,
@@ -417,6 +429,8 @@ func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		"reset_all_parameters": {Ignore: true},
 		"delete_directly":      {Ignore: true},
 		"parameters":           {Ignore: true},
+		"parameter_group_id":   {Ignore: true},
+		"backup_id":            {Ignore: true},
 		"security_group_id":    {Ignore: true},
 		"protocol": {ValueFunc: func(d *schema.ResourceData) (interface{}, bool) {
 			v, ok := d.GetOk("protocol")
@@ -462,6 +476,11 @@ func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("error on create Instance: %s", err)
 	}
+	// restore the data from backup
+	err = restoreRedisInstanceFromBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on create Instance: %s", err)
+	}
 	if len(*createParam) > 0 {
 		err = setResourceRedisInstanceParameter(d, meta, createParam)
 		if err != nil {
@@ -668,3 +687,22 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	return d.Set("reset_all_parameters", d.Get("reset_all_parameters"))
 }
+
+func redisInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("mode").(int) != 3 {
+		return nil
+	}
+	// only check the new instances and the resharding, the existing instances are left as they are
+	if diff.Id() != "" && !diff.HasChange("shard_num") && !diff.HasChange("shard_size") && !diff.HasChange("capacity") {
+		return nil
+	}
+	shardSize := diff.Get("shard_size").(int)
+	shardNum := diff.Get("shard_num").(int)
+	if shardSize == 0 || shardNum == 0 {
+		return nil
+	}
+	if capacity := diff.Get("capacity").(int); capacity != shardSize*shardNum {
+		return fmt.Errorf("capacity must be equal to shard_size * shard_num when mode is 3, expected %d, got %d", shardSize*shardNum, capacity)
+	}
+	return nil
+}
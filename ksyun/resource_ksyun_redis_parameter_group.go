@@ -0,0 +1,143 @@
+/*
+Provides a redis parameter group resource, which can be shared by multiple redis instances.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_redis_parameter_group" "default" {
+	  available_zone = "cn-beijing-6a"
+	  name           = "tf-redis-parameter-group"
+	  description    = "shared parameters"
+	  protocol       = "4.0"
+	  parameters = {
+	    "maxmemory-policy" = "volatile-lru"
+	    "timeout"          = "600"
+	  }
+	}
+
+	resource "ksyun_redis_instance" "default" {
+	  available_zone     = "cn-beijing-6a"
+	  name               = "tf-redis"
+	  mode               = 2
+	  capacity           = 1
+	  vnet_id            = ksyun_subnet.default.id
+	  vpc_id             = ksyun_vpc.default.id
+	  pass_word          = "Shiwo1101"
+	  protocol           = "4.0"
+	  parameter_group_id = ksyun_redis_parameter_group.default.id
+	}
+
+```
+
+# Import
+
+redis parameter group can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_redis_parameter_group.default 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceRedisParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRedisParameterGroupCreate,
+		Read:   resourceRedisParameterGroupRead,
+		Update: resourceRedisParameterGroupUpdate,
+		Delete: resourceRedisParameterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"available_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The Zone of the parameter group.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the parameter group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the parameter group.",
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"4.0",
+					"5.0",
+					"6.0",
+				}, false),
+				Description: "Engine version of the parameter group. Supported values: 4.0, 5.0 and 6.0.",
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        schema.TypeString,
+				Description: "The parameters of the group. Available parameters can refer to the docs https://docs.ksyun.com/documents/1018.",
+			},
+		},
+	}
+}
+
+func resourceRedisParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	err := createRedisParameterGroup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating redis parameter group: %s", err)
+	}
+	return resourceRedisParameterGroupRead(d, meta)
+}
+
+func resourceRedisParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	item, err := readRedisParameterGroup(d, meta, "")
+	if err != nil {
+		return fmt.Errorf("error on reading redis parameter group %q, %s", d.Id(), err)
+	}
+	extra := map[string]SdkResponseMapping{
+		"engineVersion": {
+			Field: "protocol",
+		},
+	}
+	SdkResponseAutoResourceData(d, resourceRedisParameterGroup(), item, extra)
+
+	// only the parameters in the configuration are saved
+	local := d.Get("parameters").(map[string]interface{})
+	parameters := make(map[string]interface{})
+	for k, v := range redisParameterGroupParameters(item) {
+		if _, ok := local[k]; ok || len(local) == 0 {
+			parameters[k] = v
+		}
+	}
+	return d.Set("parameters", parameters)
+}
+
+func resourceRedisParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := modifyRedisParameterGroup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on updating redis parameter group %q, %s", d.Id(), err)
+	}
+	return resourceRedisParameterGroupRead(d, meta)
+}
+
+func resourceRedisParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	err := removeRedisParameterGroup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on deleting redis parameter group %q, %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunRedisParameterGroup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_redis_parameter_group.default",
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisParameterGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_redis_parameter_group.default"),
+					resource.TestCheckResourceAttr("ksyun_redis_parameter_group.default", "parameters.timeout", "600"),
+					resource.TestCheckResourceAttrPair("ksyun_redis_instance.shared", "parameter_group_id", "ksyun_redis_parameter_group.default", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRedisParameterGroupConfig = testAccRedisBase + `
+resource "ksyun_redis_parameter_group" "default" {
+  available_zone = "cn-beijing-6a"
+  name           = "tf-acc-redis-parameter-group"
+  description    = "tf acc test"
+  protocol       = "4.0"
+  parameters = {
+    "maxmemory-policy" = "volatile-lru"
+    "timeout"          = "600"
+  }
+}
+
+resource "ksyun_redis_instance" "shared" {
+  available_zone     = "cn-beijing-6a"
+  name               = "tf-acc-redis-shared"
+  mode               = 2
+  capacity           = 1
+  vnet_id            = ksyun_subnet.default.id
+  vpc_id             = ksyun_vpc.default.id
+  pass_word          = "Shiwo1101"
+  protocol           = "4.0"
+  parameter_group_id = ksyun_redis_parameter_group.default.id
+  parameters = {
+    "timeout" = "300"
+  }
+}
+`
@@ -12,6 +12,17 @@ Provides a ScalingConfiguration resource.
 	  password = "Aa123456"
 	}
 
+	# spot instances with a max price
+	resource "ksyun_scaling_configuration" "spot" {
+	  scaling_configuration_name = "tf-spot"
+	  image_id = "IMG-5465174a-6d71-4770-b8e1-917a0dd92466"
+	  instance_type = "N3.1B"
+	  password = "Aa123456"
+	  spot_strategy = "SpotWithPriceLimit"
+	  spot_price_limit = 0.5
+	  spot_interruption_behavior = "Terminate"
+	}
+
 ```
 
 # Import
@@ -34,7 +45,7 @@ import (
 )
 
 func resourceKsyunScalingConfiguration() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceKsyunScalingConfigurationCreate,
 		Read:   resourceKsyunScalingConfigurationRead,
 		Delete: resourceKsyunScalingConfigurationDelete,
@@ -259,6 +270,10 @@ func resourceKsyunScalingConfiguration() *schema.Resource {
 			},
 		},
 	}
+	for k, v := range kecSpotConfig(false) {
+		r.Schema[k] = v
+	}
+	return r
 }
 
 func resourceKsyunScalingConfigurationExtra(d *schema.ResourceData, forceGet bool) map[string]SdkRequestMapping {
@@ -288,6 +303,11 @@ func resourceKsyunScalingConfigurationCreate(d *schema.ResourceData, meta interf
 	var resp *map[string]interface{}
 	var err error
 
+	err = checkKecSpotParams(d)
+	if err != nil {
+		return fmt.Errorf("error on creating ScalingConfiguration, %s", err)
+	}
+
 	createScalingConfiguration, err := SdkRequestAutoMapping(d, scalingConfiguration, false, nil,
 		resourceKsyunScalingConfigurationExtra(d, false))
 	if err != nil {
@@ -313,6 +333,11 @@ func resourceKsyunScalingConfigurationUpdate(d *schema.ResourceData, meta interf
 
 	var err error
 
+	err = checkKecSpotParams(d)
+	if err != nil {
+		return fmt.Errorf("error on modifying ScalingConfiguration, %s", err)
+	}
+
 	modifyScalingConfiguration, err := SdkRequestAutoMapping(d, scalingConfiguration, true, nil, resourceKsyunScalingConfigurationExtra(d, true))
 	if err != nil {
 		return fmt.Errorf("error on modifying ScalingConfiguration, %s", err)
@@ -9,6 +9,8 @@ Provides a ScalingGroup resource.
 	  subnet_id_set = [ksyun_subnet.foo.id]
 	  security_group_id = ksyun_security_group.foo.id
 	  scaling_configuration_id = ksyun_scaling_configuration.foo.id
+	  # or launch the instances from a KEC launch template
+	  # launch_template_id = ksyun_kec_launch_template.foo.id
 	  min_size = 0
 	  max_size = 2
 	  desired_capacity = 0
@@ -70,9 +72,38 @@ func resourceKsyunScalingGroup() *schema.Resource {
 				Description: "The Name of the desired ScalingGroup.",
 			},
 			"scaling_configuration_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The Scaling Configuration ID of the desired ScalingGroup set to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"scaling_configuration_id", "launch_template_id"},
+				Description:  "The Scaling Configuration ID of the desired ScalingGroup set to. Conflict with `launch_template_id`.",
+			},
+			"launch_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"scaling_configuration_id", "launch_template_id"},
+				Description:  "The ID of the KEC launch template that the instances of the ScalingGroup are launched from. Conflict with `scaling_configuration_id`.",
+			},
+			"launch_template_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version number of the KEC launch template. The default version of the launch template is used if not set.",
+			},
+
+			"on_demand_base_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+				Description:  "The minimum number of on-demand instances in the ScalingGroup, the rest capacity is allocated by `on_demand_percentage_above_base_capacity`. It only works when the scaling configuration launches spot instances.",
+			},
+			"on_demand_percentage_above_base_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  "The percentage of on-demand instances in the capacity beyond `on_demand_base_capacity`, the others are spot instances. Valid Value 0-100.",
 			},
 
 			"min_size": {
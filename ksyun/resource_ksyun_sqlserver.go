@@ -1,22 +1,61 @@
+/*
+Provides an SQL Server instance resource.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_sqlserver" "foo" {
+	  db_instance_class    = "db.ram.2|db.disk.100"
+	  db_instance_name     = "tf-sqlserver"
+	  db_instance_type     = "HRDS_SS"
+	  engine               = "SQLServer"
+	  engine_version       = "2008r2"
+	  master_user_name     = "admin"
+	  master_user_password = "123qweASD"
+	  vpc_id               = ksyun_vpc.default.id
+	  subnet_id            = ksyun_subnet.default.id
+	  bill_type            = "DAY"
+
+	  parameters {
+	    name  = "max degree of parallelism"
+	    value = "2"
+	  }
+	}
+
+```
+
+# Import
+
+SQL Server instance can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_sqlserver.foo 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
 package ksyun
 
 import (
 	"fmt"
-	"github.com/KscSDK/ksc-sdk-go/service/sqlserver"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
-var getSqlserverInTheCar = map[string]bool{
-	"db_instance_identifier": true,
-	"instance_create_time":   true,
-	"port":                   true,
-	"sub_order_id":           true,
-	"availability_zone_1":    true,
-	"availability_zone_2":    true,
-	"region":                 true,
+// sqlServerNotSupportUpdate lists the fields that can only be set on creation.
+var sqlServerNotSupportUpdate = []string{
+	"db_instance_type",
+	"engine",
+	"engine_version",
+	"master_user_name",
+	"vpc_id",
+	"subnet_id",
+	"bill_type",
+	"duration",
+	"availability_zone_1",
+	"availability_zone_2",
+	"project_id",
+	"port",
 }
 
 func resourceKsyunSqlServer() *schema.Resource {
@@ -38,313 +77,195 @@ func resourceKsyunSqlServer() *schema.Resource {
 			"db_instance_identifier": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "source instance identifier",
 				Computed:    true,
+				Description: "instance identifier.",
 			},
 			"db_instance_class": {
-				Type:     schema.TypeString,
-				Required: true,
-				Description: "this value regex db.ram.d{1,3}|db.disk.d{1,5} , " +
-					"db.ram is rds random access memory size, db.disk is disk size",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validDbInstanceClass(),
+				Description: "this value regex db.ram.d{1,3}|db.disk.d{1,5}, " +
+					"db.ram is rds random access memory size, db.disk is disk size.",
 			},
 			"db_instance_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "instance name.",
 			},
 			"db_instance_type": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "HRDS_SS",
+				Description: "instance type, valid value: HRDS_SS.",
 			},
 			"engine": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "engine is db type, only support SQLServer",
+				Description: "engine is db type, only support SQLServer.",
 			},
 			"engine_version": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "db engine version only support 2008r2,2012,2016",
+				Description: "db engine version only support 2008r2,2012,2016.",
 			},
 			"region": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "region code.",
 			},
 			"master_user_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "database primary account name.",
 			},
 			"master_user_password": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "master account password.",
 			},
 			"vpc_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "the id of the vpc.",
 			},
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "the id of the subnet.",
 			},
 			"bill_type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "bill type, valid values: DAY, YEAR_MONTH, HourlyInstantSettlement.",
 			},
 			"duration": {
-				Type:     schema.TypeInt,
-				Required: false,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "purchase duration in months.",
 			},
 			"security_group_id": {
 				Type:        schema.TypeString,
-				Required:    false,
 				Optional:    true,
-				Description: "proprietary security group id for krds",
+				Computed:    true,
+				Description: "proprietary security group id for sqlserver, changing it moves the instance to the new security group.",
 			},
 			"preferred_backup_time": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "backup time.",
 			},
 			"availability_zone_1": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "zone 1.",
 			},
 			"availability_zone_2": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "zone 2.",
 			},
 			"project_id": {
-				Type:     schema.TypeInt,
-				Required: false,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "project id.",
 			},
 			"port": {
-				Type:     schema.TypeInt,
-				Required: false,
-				Optional: true,
-				Computed: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "port number.",
+			},
+			"parameters": {
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "name of the parameter.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "value of the parameter.",
+						},
+					},
+				},
+				Set:         parameterToHash,
+				Optional:    true,
+				Computed:    true,
+				Description: "database parameters.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "virtual IP of the instance.",
+			},
+			"db_instance_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "status of the instance.",
 			},
 			"sub_order_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Deprecated:  "This field is read only and setting it has no effect, it will be Computed only in a future release.",
+				Description: "sub order id.",
 			},
 			"instance_create_time": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Deprecated:  "This field is read only and setting it has no effect, it will be Computed only in a future release.",
+				Description: "instance create time.",
 			},
 		},
 	}
 }
-func resourceKsyunSqlServerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*KsyunClient).sqlserverconn
-	var resp *map[string]interface{}
-	createReq := make(map[string]interface{})
-	var err error
-	creates := []string{
-		"DBInstanceClass",
-		"DBInstanceName",
-		"DBInstanceType",
-		"Engine",
-		"EngineVersion",
-		"MasterUserName",
-		"MasterUserPassword",
-		"VpcId",
-		"SubnetId",
-		"BillType",
-		"Duration",
-		"SecurityGroupId",
-		"PreferredBackupTime",
-		"AvailabilityZone.1",
-		"AvailabilityZone.2",
-		"ProjectId",
-		"Port",
-	}
-	for _, v := range creates {
-		if v1, ok := d.GetOk(Camel2Hungarian(v)); ok {
-			createReq[v] = fmt.Sprintf("%v", v1)
-		}
-	}
-	action := "CreateDBInstance"
-	logger.Debug(logger.RespFormat, action, createReq)
-	resp, err = conn.CreateDBInstance(&createReq)
-	logger.Debug(logger.AllFormat, action, createReq, *resp, err)
-	if err != nil {
-		return fmt.Errorf("error on creating Instance(sqlserver): %s", err)
-	}
 
-	if resp != nil {
-		bodyData := (*resp)["Data"].(map[string]interface{})
-		instances := bodyData["Instances"].([]interface{})
-		sqlserverInstance := instances[0].(map[string]interface{})
-		instanceId := sqlserverInstance["DBInstanceIdentifier"].(string)
-		logger.DebugInfo(" DBInstanceIdentifier : %v", instanceId)
-		d.SetId(instanceId)
-	}
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{tCreatingStatus},
-		Target:     []string{tActiveStatus, tFailedStatus, tDeletedStatus, tStopedStatus},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-		Refresh:    sqlserverInstanceStateRefreshForCreate(conn, d.Id(), []string{tCreatingStatus}),
-	}
-	_, err = stateConf.WaitForState()
+func resourceKsyunSqlServerCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.CreateSqlServer(d, resourceKsyunSqlServer())
 	if err != nil {
-		return err
+		return fmt.Errorf("error on creating sqlserver instance: %s", err)
 	}
-
 	return resourceKsyunSqlServerRead(d, meta)
 }
 
-func sqlserverInstanceStateRefreshForCreate(client *sqlserver.Sqlserver, instanceId string, target []string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		req := map[string]interface{}{"DBInstanceIdentifier": instanceId}
-		action := "DescribeDBInstances"
-		logger.Debug(logger.ReqFormat, action, req)
-		resp, err := client.DescribeDBInstances(&req)
-		logger.Debug(logger.AllFormat, action, req, *resp, err)
-		if err != nil {
-			return nil, "", err
-		}
-		bodyData := (*resp)["Data"].(map[string]interface{})
-		instances := bodyData["Instances"].([]interface{})
-		sqlserverInstance := instances[0].(map[string]interface{})
-		state := sqlserverInstance["DBInstanceStatus"].(string)
-
-		return resp, state, nil
-
-	}
-}
-
-func resourceKsyunSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*KsyunClient).sqlserverconn
-	req := map[string]interface{}{"DBInstanceIdentifier": d.Id()}
-	action := "DescribeDBInstances"
-	logger.Debug(logger.ReqFormat, action, req)
-	resp, err := conn.DescribeDBInstances(&req)
-	logger.Debug(logger.AllFormat, action, req, *resp, err)
+func resourceKsyunSqlServerRead(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.ReadAndSetSqlServer(d, resourceKsyunSqlServer(), false)
 	if err != nil {
-		return fmt.Errorf("error on reading Instance(sqlserver) %q, %s", d.Id(), err)
-	}
-
-	bodyData, dataOk := (*resp)["Data"].(map[string]interface{})
-	if !dataOk {
-		return fmt.Errorf("error on reading Instance(sqlserver) body %q, %+v", d.Id(), (*resp)["Error"])
-	}
-	instances := bodyData["Instances"].([]interface{})
-
-	sqlserverIds := make([]string, len(instances))
-	sqlserverMap := make([]map[string]interface{}, len(instances))
-	for k, instance := range instances {
-		instanceInfo, _ := instance.(map[string]interface{})
-		instInfo := make(map[string]interface{})
-		for k, v := range instanceInfo {
-			if k == "DBInstanceClass" {
-			} else if k == "ReadReplicaDBInstanceIdentifiers" {
-			} else if k == "DBSource" {
-			} else {
-				instInfo[Camel2Hungarian(k)] = v
-			}
-		}
-		sqlserverMap[k] = instInfo
-		logger.DebugInfo(" converted ---- %+v ", instInfo)
-
-		sqlserverIds[k] = instInfo["db_instance_identifier"].(string)
-		logger.DebugInfo("sqlserverIds fuck : %v", sqlserverIds)
+		return fmt.Errorf("error on reading sqlserver instance %q, %s", d.Id(), err)
 	}
-
-	logger.DebugInfo(" converted ---- %+v ", sqlserverMap)
-	_ = SetDByFkResp(d, sqlserverMap[0], getSqlserverInTheCar)
-	return nil
+	return err
 }
 
-func resourceKsyunSqlServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	// 关闭事务，允许部分属性被修改  d.Partial(true) d.Partial(false)
-	updateField := []string{
-		"db_instance_class",
-		"db_instance_name",
-		"db_instance_type",
-		"engine",
-		"engine_version",
-		"master_user_name",
-		"master_user_password",
-		"vpc_id",
-		"subnet_id",
-		"bill_type",
-		"duration",
-		"security_group_id",
-		"preferred_backup_time",
-		"availability_zone_1",
-		"availability_zone_2",
-		"project_id",
-		"port",
-	}
-	d.Partial(true)
-	for _, v := range updateField {
+func resourceKsyunSqlServerUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	for _, v := range sqlServerNotSupportUpdate {
 		if d.HasChange(v) {
-			return fmt.Errorf("error on updating instance , sqlserver is not support update")
+			return fmt.Errorf("error on updating sqlserver instance, %s is not support update", v)
 		}
 	}
-	d.Partial(false)
-	return nil
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.ModifySqlServer(d, resourceKsyunSqlServer())
+	if err != nil {
+		return fmt.Errorf("error on updating sqlserver instance %q, %s", d.Id(), err)
+	}
+	return resourceKsyunSqlServerRead(d, meta)
 }
 
-func resourceKsyunSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*KsyunClient).sqlserverconn
-	deleteReq := make(map[string]interface{})
-	deleteReq["DBInstanceIdentifier"] = d.Id()
-
-	return resource.Retry(15*time.Minute, func() *resource.RetryError {
-		readReq := map[string]interface{}{"DBInstanceIdentifier": d.Id()}
-		discribeAction := "DescribeInstances"
-		logger.Debug(logger.ReqFormat, discribeAction, readReq)
-		desResp, desErr := conn.DescribeDBInstances(&readReq)
-		logger.Debug(logger.AllFormat, discribeAction, readReq, *desResp, desErr)
-
-		if desErr != nil {
-			if notFoundError(desErr) {
-				return nil
-			} else {
-				return resource.NonRetryableError(desErr)
-			}
-		}
-
-		bodyData := (*desResp)["Data"].(map[string]interface{})
-		instances := bodyData["Instances"].([]interface{})
-		sqlserverInstance := instances[0].(map[string]interface{})
-		state := sqlserverInstance["DBInstanceStatus"].(string)
-
-		if state != tDeletedStatus {
-			deleteAction := "DeleteDBInstance"
-			logger.Debug(logger.ReqFormat, deleteAction, deleteReq)
-			deleteResp, deleteErr := conn.DeleteDBInstance(&deleteReq)
-			logger.Debug(logger.AllFormat, deleteAction, deleteReq, *deleteResp, deleteErr)
-			if deleteErr == nil || notFoundError(deleteErr) {
-				return nil
-			}
-			if deleteErr != nil {
-				return resource.RetryableError(deleteErr)
-			}
-
-			logger.Debug(logger.ReqFormat, discribeAction, readReq)
-			postDesResp, postDesErr := conn.DescribeDBInstances(&readReq)
-			logger.Debug(logger.AllFormat, discribeAction, readReq, *postDesResp, postDesErr)
-
-			if desErr != nil {
-				if notFoundError(desErr) {
-					return nil
-				} else {
-					return resource.NonRetryableError(fmt.Errorf("error on  reading kec when delete %q, %s", d.Id(), desErr))
-				}
-			}
-		}
-
-		return resource.RetryableError(desErr)
-	})
+func resourceKsyunSqlServerDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.RemoveSqlServer(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting sqlserver instance %q, %s", d.Id(), err)
+	}
+	return err
 }
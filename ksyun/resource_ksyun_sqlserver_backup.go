@@ -0,0 +1,129 @@
+/*
+Provides an on-demand backup of the SQL Server instance.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_sqlserver_backup" "foo" {
+	  db_instance_identifier = ksyun_sqlserver.default.id
+	  backup_name            = "tf-sqlserver-backup"
+	}
+
+```
+
+# Import
+
+SQL Server backup can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_sqlserver_backup.foo ${db_instance_identifier}:${backup_id}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunSqlServerBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunSqlServerBackupCreate,
+		Read:   resourceKsyunSqlServerBackupRead,
+		Delete: resourceKsyunSqlServerBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKrdsBackup,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the SQL Server instance.",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the backup.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the backup.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the backup.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backup.",
+			},
+			"backup_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode of the backup.",
+			},
+			"backup_size": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The size of the backup, unit: MB.",
+			},
+			"backup_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"backup_create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup started.",
+			},
+			"backup_updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup finished.",
+			},
+		},
+	}
+}
+
+func resourceKsyunSqlServerBackupCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.CreateSqlServerBackup(d, resourceKsyunSqlServerBackup())
+	if err != nil {
+		return fmt.Errorf("error on creating sqlserver backup: %s", err)
+	}
+	return resourceKsyunSqlServerBackupRead(d, meta)
+}
+
+func resourceKsyunSqlServerBackupRead(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.ReadAndSetSqlServerBackup(d, resourceKsyunSqlServerBackup())
+	if err != nil {
+		return fmt.Errorf("error on reading sqlserver backup %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunSqlServerBackupDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.RemoveSqlServerBackup(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting sqlserver backup %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,35 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKsyunSqlServerBackup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_sqlserver_backup.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSqlServerBackupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_sqlserver_backup.foo"),
+					resource.TestCheckResourceAttr("ksyun_sqlserver_backup.foo", "backup_status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSqlServerBackupConfig = testAccSqlServerBase + `
+resource "ksyun_sqlserver_backup" "foo" {
+  db_instance_identifier = ksyun_sqlserver.default.id
+  backup_name            = "tf-acc-sqlserver-backup"
+}
+`
@@ -0,0 +1,150 @@
+/*
+Provides an SQL Server read only instance resource.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_sqlserver_rr" "foo" {
+	  db_instance_identifier = ksyun_sqlserver.default.id
+	  db_instance_class      = "db.ram.2|db.disk.100"
+	  db_instance_name       = "tf-sqlserver-rr"
+	  bill_type              = "DAY"
+	}
+
+```
+
+# Import
+
+SQL Server read only instance can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_sqlserver_rr.foo 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var sqlServerRrNotSupport = []string{
+	"availability_zone_2",
+	"master_user_name",
+	"master_user_password",
+	"vpc_id",
+	"subnet_id",
+	"preferred_backup_time",
+}
+
+var sqlServerRrNotSupportUpdate = []string{
+	"bill_type",
+	"duration",
+	"project_id",
+}
+
+func resourceKsyunSqlServerRr() *schema.Resource {
+	rrSchema := resourceKsyunSqlServer().Schema
+	for _, n := range sqlServerRrNotSupport {
+		delete(rrSchema, n)
+	}
+	rrSchema["db_instance_identifier"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "the id of the SQL Server instance to replicate from.",
+	}
+	rrSchema["db_instance_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "instance type.",
+	}
+	rrSchema["engine"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "engine is db type.",
+	}
+	rrSchema["engine_version"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "db engine version.",
+	}
+	rrSchema["availability_zone_1"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "zone of the read only instance.",
+	}
+	rrSchema["bill_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "DAY",
+		Description: "bill type, valid values: DAY, YEAR_MONTH, HourlyInstantSettlement.",
+	}
+	rrSchema["port"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "port number.",
+	}
+
+	return &schema.Resource{
+		Create: resourceKsyunSqlServerRrCreate,
+		Update: resourceKsyunSqlServerRrUpdate,
+		Read:   resourceKsyunSqlServerRrRead,
+		Delete: resourceKsyunSqlServerRrDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: rrSchema,
+	}
+}
+
+func resourceKsyunSqlServerRrCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.CreateSqlServerRr(d, resourceKsyunSqlServerRr())
+	if err != nil {
+		return fmt.Errorf("error on creating sqlserver rr instance: %s", err)
+	}
+	return resourceKsyunSqlServerRrRead(d, meta)
+}
+
+func resourceKsyunSqlServerRrRead(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.ReadAndSetSqlServer(d, resourceKsyunSqlServerRr(), true)
+	if err != nil {
+		return fmt.Errorf("error on reading sqlserver rr instance %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunSqlServerRrUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	for _, v := range sqlServerRrNotSupportUpdate {
+		if d.HasChange(v) {
+			return fmt.Errorf("error on updating sqlserver rr instance, %s is not support update", v)
+		}
+	}
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.ModifySqlServer(d, resourceKsyunSqlServerRr())
+	if err != nil {
+		return fmt.Errorf("error on updating sqlserver rr instance %q, %s", d.Id(), err)
+	}
+	return resourceKsyunSqlServerRrRead(d, meta)
+}
+
+func resourceKsyunSqlServerRrDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	sqlServerService := SqlServerService{meta.(*KsyunClient)}
+	err = sqlServerService.RemoveSqlServer(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting sqlserver rr instance %q, %s", d.Id(), err)
+	}
+	return err
+}
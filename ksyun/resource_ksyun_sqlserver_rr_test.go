@@ -0,0 +1,72 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKsyunSqlServerRr_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ksyun_sqlserver_rr.foo",
+		Providers:     testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSqlServerRrConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_sqlserver_rr.foo"),
+					resource.TestCheckResourceAttr("ksyun_sqlserver_rr.foo", "db_instance_type", "RR"),
+					resource.TestCheckResourceAttrPair("ksyun_sqlserver_rr.foo", "db_instance_identifier", "ksyun_sqlserver.default", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSqlServerBase = `
+provider "ksyun" {
+  region = "cn-shanghai-2"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-acc-sqlserver-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-acc-sqlserver-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Reserve"
+  vpc_id            = ksyun_vpc.default.id
+  gateway_ip        = "10.7.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-shanghai-2a"
+}
+
+resource "ksyun_sqlserver" "default" {
+  db_instance_class    = "db.ram.2|db.disk.100"
+  db_instance_name     = "tf-acc-sqlserver"
+  db_instance_type     = "HRDS_SS"
+  engine               = "SQLServer"
+  engine_version       = "2008r2"
+  master_user_name     = "admin"
+  master_user_password = "123qweASD"
+  vpc_id               = ksyun_vpc.default.id
+  subnet_id            = ksyun_subnet.default.id
+  bill_type            = "DAY"
+}
+`
+
+const testAccSqlServerRrConfig = testAccSqlServerBase + `
+resource "ksyun_sqlserver_rr" "foo" {
+  db_instance_identifier = ksyun_sqlserver.default.id
+  db_instance_class      = "db.ram.2|db.disk.100"
+  db_instance_name       = "tf-acc-sqlserver-rr"
+}
+`
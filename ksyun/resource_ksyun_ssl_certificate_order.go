@@ -0,0 +1,177 @@
+/*
+Provides a resource which orders a domain-validated SSL certificate.
+
+The order exposes the DNS records which prove the ownership of the domains, the certificate is issued after the records are created.
+Use `ksyun_ssl_certificate_order_validation` to wait for the issuance and store the certificate in KCM,
+so the certificate can be used by `ksyun_lb_listener` and `ksyun_alb_listener`.
+
+~> **NOTE:** The CA resolves the validation records from the public DNS, so the records must be created in the zone which serves the domain publicly.
+The zones of `ksyun_private_dns_zone` are only resolved inside the VPC, they can't validate the order unless the same records are also served publicly (split-horizon DNS).
+
+Destroying the resource cancels the order which has not been issued, the issued certificate is kept valid until it expires.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_ssl_certificate_order" "default" {
+	  domain                    = "www.example.com"
+	  subject_alternative_names = ["api.example.com"]
+	}
+
+	# the validation records are created in the public DNS which serves example.com, such as Cloudflare
+	resource "cloudflare_record" "validation" {
+	  count   = length(ksyun_ssl_certificate_order.default.validation_records)
+	  zone_id = var.cloudflare_zone_id
+	  name    = ksyun_ssl_certificate_order.default.validation_records[count.index].record_name
+	  type    = ksyun_ssl_certificate_order.default.validation_records[count.index].record_type
+	  value   = ksyun_ssl_certificate_order.default.validation_records[count.index].record_value
+	  ttl     = 60
+	}
+
+	resource "ksyun_ssl_certificate_order_validation" "default" {
+	  order_id                = ksyun_ssl_certificate_order.default.id
+	  validation_record_fqdns = ksyun_ssl_certificate_order.default.validation_records.*.record_name
+	  certificate_name        = "tf-www-example-com"
+
+	  depends_on = [cloudflare_record.validation]
+	}
+
+	resource "ksyun_lb_listener" "default" {
+	  listener_name     = "tf-https"
+	  listener_port     = 443
+	  listener_protocol = "HTTPS"
+	  load_balancer_id  = ksyun_lb.default.id
+	  method            = "RoundRobin"
+	  certificate_id    = ksyun_ssl_certificate_order_validation.default.certificate_id
+	}
+
+```
+
+# Import
+
+SSL certificate order can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_ssl_certificate_order.default 2f3e5a9c-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceKsyunSslCertificateOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunSslCertificateOrderCreate,
+		Read:   resourceKsyunSslCertificateOrderRead,
+		Delete: resourceKsyunSslCertificateOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain of the certificate, which is the common name of the subject, such as `www.example.com` or `*.example.com`.",
+			},
+			"subject_alternative_names": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The additional domains of the certificate.",
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RSA",
+				ValidateFunc: validation.StringInSlice([]string{"RSA", "ECC"}, false),
+				Description:  "The algorithm of the key of the certificate. Valid values: `RSA`, `ECC`. Default is `RSA`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the order, such as `PendingValidation`, `Issued` and `Failed`.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the order is created.",
+			},
+			"validation_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNS records which validate the domains of the certificate.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain validated by the record.",
+						},
+						"record_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the record, such as `_dnsauth.www.example.com`.",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the record, such as `TXT` and `CNAME`.",
+						},
+						"record_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the record.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKsyunSslCertificateOrderCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	sslService := SslService{meta.(*KsyunClient)}
+	err = sslService.CreateSslCertificateOrder(d, resourceKsyunSslCertificateOrder())
+	if err != nil {
+		return fmt.Errorf("error on creating ssl certificate order: %s", err)
+	}
+	return resourceKsyunSslCertificateOrderRead(d, meta)
+}
+
+func resourceKsyunSslCertificateOrderRead(d *schema.ResourceData, meta interface{}) (err error) {
+	sslService := SslService{meta.(*KsyunClient)}
+	err = sslService.ReadAndSetSslCertificateOrder(d, resourceKsyunSslCertificateOrder())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading ssl certificate order %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunSslCertificateOrderDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	sslService := SslService{meta.(*KsyunClient)}
+	err = sslService.RemoveSslCertificateOrder(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting ssl certificate order %q, %s", d.Id(), err)
+	}
+	return err
+}
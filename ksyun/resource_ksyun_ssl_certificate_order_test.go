@@ -0,0 +1,61 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKsyunSslCertificateOrder_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: "ksyun_ssl_certificate_order.default",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSslCertificateOrderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSslCertificateOrderConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_ssl_certificate_order.default"),
+					resource.TestCheckResourceAttr("ksyun_ssl_certificate_order.default", "subject_alternative_names.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_ssl_certificate_order.default", "validation_records.#", "2"),
+					resource.TestCheckResourceAttrSet("ksyun_ssl_certificate_order.default", "validation_records.0.record_name"),
+					resource.TestCheckResourceAttrSet("ksyun_ssl_certificate_order.default", "validation_records.0.record_value"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_ssl_certificate_order.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSslCertificateOrderDestroy(s *terraform.State) error {
+	sslService := SslService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_ssl_certificate_order" {
+			continue
+		}
+		_, err := sslService.ReadSslCertificateOrder(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("ssl certificate order %s still exists", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+const testAccSslCertificateOrderConfig = `
+resource "ksyun_ssl_certificate_order" "default" {
+  domain                    = "tf-acc.example.com"
+  subject_alternative_names = ["tf-acc-api.example.com"]
+  key_algorithm             = "ECC"
+}`
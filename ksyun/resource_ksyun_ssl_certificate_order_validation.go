@@ -0,0 +1,112 @@
+/*
+Provides a resource which waits for the certificate of `ksyun_ssl_certificate_order` to be issued and stores it in KCM.
+
+The resource doesn't call the CA itself, it only waits until the DNS validation records are checked by the CA,
+so it should depend on the resources which create the records.
+The private key is passed to KCM directly and it's never saved into the state.
+Destroying the resource deletes the certificate from KCM.
+
+# Example Usage
+
+```hcl
+
+	resource "ksyun_ssl_certificate_order" "default" {
+	  domain = "www.example.com"
+	}
+
+	resource "ksyun_ssl_certificate_order_validation" "default" {
+	  order_id                = ksyun_ssl_certificate_order.default.id
+	  validation_record_fqdns = ksyun_ssl_certificate_order.default.validation_records.*.record_name
+	}
+
+	output "certificate_id" {
+	  value = ksyun_ssl_certificate_order_validation.default.certificate_id
+	}
+
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKsyunSslCertificateOrderValidation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunSslCertificateOrderValidationCreate,
+		Read:   resourceKsyunSslCertificateOrderValidationRead,
+		Delete: resourceKsyunSslCertificateOrderValidationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"order_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the ssl certificate order.",
+			},
+			"validation_record_fqdns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The full names of the validation records which have been created. If set, every validation record of the order must be in it, otherwise the creation fails without waiting.",
+			},
+			"certificate_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the certificate in KCM. Default is the ID of the order.",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the certificate in KCM, which can be used by the listeners.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the certificate expires.",
+			},
+		},
+	}
+}
+
+func resourceKsyunSslCertificateOrderValidationCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	sslService := SslService{meta.(*KsyunClient)}
+	err = sslService.CreateSslCertificateOrderValidation(d)
+	if err != nil {
+		return fmt.Errorf("error on validating ssl certificate order %q: %s", d.Get("order_id"), err)
+	}
+	return resourceKsyunSslCertificateOrderValidationRead(d, meta)
+}
+
+func resourceKsyunSslCertificateOrderValidationRead(d *schema.ResourceData, meta interface{}) (err error) {
+	sslService := SslService{meta.(*KsyunClient)}
+	err = sslService.ReadAndSetSslCertificateOrderValidation(d)
+	if err != nil {
+		// the certificate is uploaded again when the order or the certificate in KCM is gone
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading ssl certificate order validation %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunSslCertificateOrderValidationDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	sslService := SslService{meta.(*KsyunClient)}
+	err = sslService.RemoveSslCertificateOrderValidation(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting ssl certificate order validation %q, %s", d.Id(), err)
+	}
+	return err
+}
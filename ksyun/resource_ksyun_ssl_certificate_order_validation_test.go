@@ -0,0 +1,91 @@
+package ksyun
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// The validation needs the records in the public DNS, so it runs only for the domain given by KSYUN_SSL_DOMAIN,
+// whose validation records are created out of band.
+func TestAccKsyunSslCertificateOrderValidation_basic(t *testing.T) {
+	domain := os.Getenv("KSYUN_SSL_DOMAIN")
+	if domain == "" {
+		t.Skip("KSYUN_SSL_DOMAIN must be set for the ssl certificate order validation test")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSslCertificateOrderValidationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccSslCertificateOrderValidationConfig, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_ssl_certificate_order_validation.default"),
+					resource.TestCheckResourceAttrSet("ksyun_ssl_certificate_order_validation.default", "certificate_id"),
+					resource.TestCheckResourceAttrSet("ksyun_ssl_certificate_order_validation.default", "not_after"),
+					resource.TestCheckResourceAttr("ksyun_ssl_certificate_order_validation.default", "certificate_name", "tf-acc-ssl-certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKsyunSslCertificateOrderValidation_missingRecords(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSslCertificateOrderValidationMissingConfig,
+				ExpectError: regexp.MustCompile("are not in validation_record_fqdns"),
+			},
+		},
+	})
+}
+
+func testAccCheckSslCertificateOrderValidationDestroy(s *terraform.State) error {
+	kcmService := KcmService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_ssl_certificate_order_validation" {
+			continue
+		}
+		certificateId := rs.Primary.Attributes["certificate_id"]
+		_, err := kcmService.ReadCertificate(nil, certificateId)
+		if err == nil {
+			return fmt.Errorf("certificate %s still exists", certificateId)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+const testAccSslCertificateOrderValidationConfig = `
+resource "ksyun_ssl_certificate_order" "default" {
+  domain = "%s"
+}
+
+resource "ksyun_ssl_certificate_order_validation" "default" {
+  order_id         = ksyun_ssl_certificate_order.default.id
+  certificate_name = "tf-acc-ssl-certificate"
+}`
+
+const testAccSslCertificateOrderValidationMissingConfig = `
+resource "ksyun_ssl_certificate_order" "default" {
+  domain = "tf-acc-missing.example.com"
+}
+
+resource "ksyun_ssl_certificate_order_validation" "default" {
+  order_id                = ksyun_ssl_certificate_order.default.id
+  validation_record_fqdns = ["_dnsauth.other.example.com"]
+}`
@@ -19,6 +19,19 @@ Provides a EBS resource.
 		  # snapshot_id = "snapshot_id"
 		}
 
+		resource "ksyun_kms_key" "ebs" {
+		  alias = "tf-ebs-key"
+		}
+
+		resource "ksyun_volume" "encrypted" {
+		  volume_name       = "test-encrypted"
+		  volume_type       = "SSD3.0"
+		  size              = 15
+		  charge_type       = "Daily"
+		  availability_zone = "cn-shanghai-3a"
+		  kms_key_id        = ksyun_kms_key.ebs.id
+		}
+
 ```
 
 # Import
@@ -153,6 +166,21 @@ func resourceKsyunVolume() *schema.Resource {
 				Description:      "When the cloud disk snapshot opens, the snapshot id is entered.",
 			},
 
+			"encrypted": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Whether the EBS volume is encrypted. It's encrypted by the default key of EBS when `kms_key_id` is not set.",
+			},
+			"kms_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The id of the `ksyun_kms_key` which encrypts the EBS volume. The volume is encrypted when it's set.",
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
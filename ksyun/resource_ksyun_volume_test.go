@@ -51,6 +51,26 @@ func TestAccKsyunVolume_update(t *testing.T) {
 	})
 }
 
+func TestAccKsyunVolume_encrypted(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVolumeDestory,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeEncryptedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists("ksyun_volume.foo"),
+					resource.TestCheckResourceAttr("ksyun_volume.foo", "encrypted", "true"),
+					resource.TestCheckResourceAttrPair("ksyun_volume.foo", "kms_key_id", "ksyun_kms_key.key", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVolumeExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -124,3 +144,18 @@ resource "ksyun_volume" "foo" {
   volume_desc="ksyun_volume_tf_test"
 }
 `
+
+const testAccVolumeEncryptedConfig = `
+resource "ksyun_kms_key" "key" {
+  alias = "tf-acc-volume-key"
+}
+
+resource "ksyun_volume" "foo" {
+  volume_name="ksyun_volume_tf_test"
+  volume_type="SSD3.0"
+  size=10
+  charge_type="Daily"
+  availability_zone="cn-beijing-6a"
+  kms_key_id=ksyun_kms_key.key.id
+}
+`
@@ -174,6 +174,7 @@ func resourceKsyunVpnTunnel() (r *schema.Resource) {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
+				Sensitive:   true,
 				Description: "The pre_shared_key of the vpn tunnel.",
 			},
 			"ike_version": {
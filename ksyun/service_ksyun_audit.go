@@ -0,0 +1,289 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+const (
+	auditTrailStatusEnabled = "Enabled"
+	// the events are kept by the audit service for 90 days, so the recent events are looked up by default
+	auditEventsDefaultDays = 7
+)
+
+type AuditService struct {
+	client *KsyunClient
+}
+
+// auditApiCall returns an ApiCall which calls the actiontrail open api, the sdk doesn't provide the actiontrail client.
+func auditApiCall(action string, params map[string]interface{}) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(client.auditconn, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+// start audit trail
+
+func (s *AuditService) ReadAuditTrail(trailName string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"TrailName.1": trailName,
+	}
+	action := "DescribeTrails"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(s.client.auditconn, action, &req)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("TrailSet", *resp)
+	if err != nil {
+		return data, err
+	}
+	trails, _ := results.([]interface{})
+	for _, v := range trails {
+		data, _ = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("audit trail %s not exist ", trailName)
+	}
+	return data, err
+}
+
+func (s *AuditService) ReadAndSetAuditTrail(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadAuditTrail(d.Id())
+	if err != nil {
+		return err
+	}
+	extra := map[string]SdkResponseMapping{
+		"EventTypeSet": {
+			Field: "event_types",
+		},
+		"RegionSet": {
+			Field: "regions",
+		},
+		"Ks3BucketName": {
+			Field: "bucket_name",
+		},
+		"Ks3KeyPrefix": {
+			Field: "prefix",
+		},
+	}
+	SdkResponseAutoResourceData(d, r, data, extra)
+	return d.Set("enabled", data["Status"] == auditTrailStatusEnabled)
+}
+
+func auditTrailTransform() map[string]SdkReqTransform {
+	return map[string]SdkReqTransform{
+		"event_types": {
+			mapping: "EventType",
+			Type:    TransformWithN,
+		},
+		"regions": {
+			mapping: "Region",
+			Type:    TransformWithN,
+		},
+		"bucket_name": {
+			mapping: "Ks3BucketName",
+			Type:    TransformDefault,
+		},
+		"prefix": {
+			mapping: "Ks3KeyPrefix",
+			Type:    TransformDefault,
+		},
+		"enabled": {
+			Ignore: true,
+		},
+	}
+}
+
+func (s *AuditService) CreateAuditTrail(d *schema.ResourceData, r *schema.Resource) (err error) {
+	req, err := SdkRequestAutoMapping(d, r, false, auditTrailTransform(), nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	createCall := auditApiCall("CreateTrail", req)
+	createCall.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		d.SetId(d.Get("trail_name").(string))
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+	apiProcess.PutCalls(s.auditTrailLoggingCalls(d)...)
+	return apiProcess.Run()
+}
+
+// auditTrailLoggingCalls returns the call which starts or stops the delivery of the events, the trail is created as stopped.
+func (s *AuditService) auditTrailLoggingCalls(d *schema.ResourceData) (calls []ApiCall) {
+	if !d.IsNewResource() && !d.HasChange("enabled") {
+		return calls
+	}
+	enabled := d.Get("enabled").(bool)
+	if d.IsNewResource() && !enabled {
+		return calls
+	}
+	action := "StopLogging"
+	if enabled {
+		action = "StartLogging"
+	}
+	call := auditApiCall(action, map[string]interface{}{})
+	executeCall := call.executeCall
+	call.executeCall = func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+		(*call.param)["TrailName"] = d.Id()
+		return executeCall(d, client, call)
+	}
+	return append(calls, call)
+}
+
+func (s *AuditService) ModifyAuditTrail(d *schema.ResourceData, r *schema.Resource) (err error) {
+	req, err := SdkRequestAutoMapping(d, r, true, auditTrailTransform(), nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	if len(req) > 0 {
+		req["TrailName"] = d.Id()
+		apiProcess.PutCalls(auditApiCall("UpdateTrail", req))
+	}
+	apiProcess.PutCalls(s.auditTrailLoggingCalls(d)...)
+	return apiProcess.Run()
+}
+
+func (s *AuditService) RemoveAuditTrail(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	call := auditApiCall("DeleteTrail", map[string]interface{}{
+		"TrailName": d.Id(),
+	})
+	call.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		if notFoundError(baseErr) {
+			return nil
+		}
+		return baseErr
+	}
+	apiProcess.PutCalls(call)
+	return apiProcess.Run()
+}
+
+// start audit events
+
+// auditEventsLookupParams builds the request of LookupEvents, the events of the last 7 days are looked up by default.
+func auditEventsLookupParams(d *schema.ResourceData, now time.Time) (req map[string]interface{}, err error) {
+	req = map[string]interface{}{}
+	endTime := now.UTC()
+	if v, ok := d.GetOk("end_time"); ok {
+		if endTime, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return req, err
+		}
+	}
+	startTime := endTime.AddDate(0, 0, -auditEventsDefaultDays)
+	if v, ok := d.GetOk("start_time"); ok {
+		if startTime, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return req, err
+		}
+	}
+	if !startTime.Before(endTime) {
+		return req, fmt.Errorf("start_time must be earlier than end_time")
+	}
+	req["StartTime"] = startTime.UTC().Format(time.RFC3339)
+	req["EndTime"] = endTime.UTC().Format(time.RFC3339)
+
+	index := 1
+	for _, attr := range []struct {
+		field string
+		key   string
+	}{
+		{"resource_id", "ResourceId"},
+		{"resource_type", "ResourceType"},
+		{"event_name", "EventName"},
+		{"user_name", "UserName"},
+	} {
+		if v, ok := d.GetOk(attr.field); ok {
+			req[fmt.Sprintf("LookupAttribute.%d.Key", index)] = attr.key
+			req[fmt.Sprintf("LookupAttribute.%d.Value", index)] = v
+			index++
+		}
+	}
+	return req, err
+}
+
+// ReadAuditEvents looks up the events page by page with the token, until the max results are reached.
+func (s *AuditService) ReadAuditEvents(condition map[string]interface{}, maxResults int) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+		token   interface{}
+	)
+	action := "LookupEvents"
+	for len(data) < maxResults {
+		req := map[string]interface{}{}
+		for k, v := range condition {
+			req[k] = v
+		}
+		if token != nil {
+			req["NextToken"] = token
+		}
+		req["MaxResults"] = maxResults - len(data)
+		if maxResults-len(data) > 50 {
+			req["MaxResults"] = 50
+		}
+		logger.Debug(logger.ReqFormat, action, req)
+		resp, err = ksyunOpenApiCall(s.client.auditconn, action, &req)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("Events", *resp)
+		if err != nil {
+			return data, err
+		}
+		events, _ := results.([]interface{})
+		data = append(data, events...)
+		token, _ = getSdkValue("NextToken", *resp)
+		if len(events) == 0 || token == nil || fmt.Sprintf("%v", token) == "" {
+			break
+		}
+	}
+	return data, err
+}
+
+func (s *AuditService) ReadAndSetAuditEvents(d *schema.ResourceData, r *schema.Resource) (err error) {
+	req, err := auditEventsLookupParams(d, time.Now())
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadAuditEvents(req, d.Get("max_results").(int))
+	if err != nil {
+		return err
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "EventName",
+		idFiled:     "EventId",
+		targetField: "events",
+		extra: map[string]SdkResponseMapping{
+			"SourceIpAddress": {
+				Field: "source_ip",
+			},
+		},
+	})
+}
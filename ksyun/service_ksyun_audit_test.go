@@ -0,0 +1,57 @@
+package ksyun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestAuditEventsLookupParams(t *testing.T) {
+	now := time.Date(2024, 6, 8, 10, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	r := dataSourceKsyunAuditEvents()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"resource_id": "i-123",
+		"user_name":   "admin",
+	})
+	req, err := auditEventsLookupParams(d, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]interface{}{
+		"StartTime":               "2024-06-01T02:00:00Z",
+		"EndTime":                 "2024-06-08T02:00:00Z",
+		"LookupAttribute.1.Key":   "ResourceId",
+		"LookupAttribute.1.Value": "i-123",
+		"LookupAttribute.2.Key":   "UserName",
+		"LookupAttribute.2.Value": "admin",
+	}
+	if len(req) != len(expect) {
+		t.Fatalf("unexpected request %v", req)
+	}
+	for k, v := range expect {
+		if req[k] != v {
+			t.Fatalf("%s: expect %v, got %v", k, v, req[k])
+		}
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"start_time": "2024-06-05T00:00:00+08:00",
+		"end_time":   "2024-06-06T00:00:00+08:00",
+	})
+	if req, err = auditEventsLookupParams(d, now); err != nil {
+		t.Fatal(err)
+	}
+	if req["StartTime"] != "2024-06-04T16:00:00Z" || req["EndTime"] != "2024-06-05T16:00:00Z" {
+		t.Fatalf("unexpected time range %v - %v", req["StartTime"], req["EndTime"])
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"start_time": "2024-06-07T00:00:00Z",
+		"end_time":   "2024-06-06T00:00:00Z",
+	})
+	if _, err = auditEventsLookupParams(d, now); err == nil {
+		t.Fatal("the start time after the end time should be rejected")
+	}
+}
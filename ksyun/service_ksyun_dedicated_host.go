@@ -0,0 +1,372 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type DedicatedHostService struct {
+	client *KsyunClient
+}
+
+func (s *DedicatedHostService) ReadDedicatedHosts(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "MaxResults", "Marker", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.dedicatedconn
+		action := "DescribeDedicatedHosts"
+		logger.Debug(logger.ReqFormat, action, condition)
+		if condition == nil {
+			resp, err = conn.DescribeDedicatedHosts(nil)
+		} else {
+			resp, err = conn.DescribeDedicatedHosts(&condition)
+		}
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("DedicatedHostSet", *resp)
+		if err != nil {
+			return data, err
+		}
+		data = results.([]interface{})
+		return data, err
+	})
+}
+
+func (s *DedicatedHostService) ReadDedicatedHost(d *schema.ResourceData, hostId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if hostId == "" {
+		hostId = d.Id()
+	}
+	req := map[string]interface{}{
+		"DedicatedHostId.1": hostId,
+	}
+	err = addProjectInfo(d, &req, s.client)
+	if err != nil {
+		return data, err
+	}
+	results, err = s.ReadDedicatedHosts(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Dedicated host %s not exist ", hostId)
+	}
+	return data, err
+}
+
+func (s *DedicatedHostService) ReadAndSetDedicatedHost(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadDedicatedHost(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading dedicated host %q, %s", d.Id(), callErr))
+			}
+		}
+		SdkResponseAutoResourceData(d, r, data, dedicatedHostResponseMapping())
+		return nil
+	})
+}
+
+func (s *DedicatedHostService) ReadAndSetDedicatedHosts(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"ids": {
+			mapping: "DedicatedHostId",
+			Type:    TransformWithN,
+		},
+		"project_id": {
+			Type: TransformWithN,
+		},
+		"availability_zone": {
+			Type: TransformWithFilter,
+		},
+		"dedicated_type": {
+			Type: TransformWithFilter,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadDedicatedHosts(req)
+	if err != nil {
+		return err
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		idFiled:     "DedicatedHostId",
+		nameField:   "DedicatedHostName",
+		targetField: "dedicated_hosts",
+		extra:       dedicatedHostResponseMapping(),
+	})
+}
+
+func dedicatedHostResponseMapping() map[string]SdkResponseMapping {
+	return map[string]SdkResponseMapping{
+		"Instances": {
+			Field: "instance_ids",
+			FieldRespFunc: func(i interface{}) interface{} {
+				var ids []interface{}
+				if instances, ok := i.([]interface{}); ok {
+					for _, instance := range instances {
+						if m, ok := instance.(map[string]interface{}); ok {
+							ids = append(ids, m["InstanceId"])
+						} else {
+							ids = append(ids, instance)
+						}
+					}
+				}
+				return ids
+			},
+		},
+	}
+}
+
+func (s *DedicatedHostService) CreateDedicatedHost(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createDedicatedHostCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *DedicatedHostService) ModifyDedicatedHost(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	renameCall, err := s.renameDedicatedHostCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(renameCall)
+
+	autoPlacementCall, err := s.modifyDedicatedHostAutoPlacementCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(autoPlacementCall)
+
+	projectCall, err := s.modifyDedicatedHostProjectCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(projectCall)
+
+	return apiProcess.Run()
+}
+
+func (s *DedicatedHostService) RemoveDedicatedHost(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeDedicatedHostCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *DedicatedHostService) createDedicatedHostCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"dedicated_type":       {},
+		"availability_zone":    {},
+		"charge_type":          {},
+		"purchase_time":        {},
+		"dedicated_host_name":  {},
+		"dedicated_cluster_id": {},
+		"project_id":           {},
+		"auto_placement":       {},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	params["Count"] = 1
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateDedicatedHosts",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.dedicatedconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CreateDedicatedHosts(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("DedicatedHostSet.0.DedicatedHostId", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			return s.checkDedicatedHostState(d, []string{"available"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *DedicatedHostService) renameDedicatedHostCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("dedicated_host_name") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DedicatedHostId":   d.Id(),
+		"DedicatedHostName": d.Get("dedicated_host_name"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "RenameDedicatedHost",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.dedicatedconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.RenameDedicatedHost(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *DedicatedHostService) modifyDedicatedHostAutoPlacementCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("auto_placement") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DedicatedHostId": d.Id(),
+		"AutoPlacement":   d.Get("auto_placement"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyDedicatedHostAttribute",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.dedicatedconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *DedicatedHostService) modifyDedicatedHostProjectCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"project_id": {},
+	}
+	updateReq, err := SdkRequestAutoMapping(d, r, true, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+	if len(updateReq) > 0 {
+		callback = ApiCall{
+			param: &updateReq,
+			executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+				return resp, ModifyProjectInstanceNew(d.Id(), call.param, client)
+			},
+			afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+				return err
+			},
+		}
+	}
+	return callback, err
+}
+
+func (s *DedicatedHostService) removeDedicatedHostCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DedicatedHostId": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteDedicatedHost",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.dedicatedconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.DeleteDedicatedHost(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadDedicatedHost(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading dedicated host when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *DedicatedHostService) checkDedicatedHostState(d *schema.ResourceData, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     target,
+		Refresh:    s.dedicatedHostStateRefreshFunc(d, []string{"error"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 1 * time.Minute,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *DedicatedHostService) dedicatedHostStateRefreshFunc(d *schema.ResourceData, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadDedicatedHost(d, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status, err := getSdkValue("State", data)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, v := range failStates {
+			if v == status.(string) {
+				return nil, "", fmt.Errorf("dedicated host status error, status:%v", status)
+			}
+		}
+		return data, status.(string), nil
+	}
+}
@@ -195,3 +195,133 @@ func (s *IamGroupService) ReadAndSetIamGroups(d *schema.ResourceData, r *schema.
 		extra:       map[string]SdkResponseMapping{},
 	})
 }
+
+func (s *IamGroupService) ReadIamGroupsForUser(userName string) (groups []string, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.iamconn
+	condition := map[string]interface{}{
+		"UserName": userName,
+		"MaxItems": 1000,
+	}
+	action := "ListGroupsForUser"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.ListGroupsForUser(&condition)
+	if err != nil {
+		return groups, err
+	}
+	results, err = getSdkValue("ListGroupsForUserResult.Groups.member", *resp)
+	if err != nil || results == nil {
+		return groups, err
+	}
+	for _, item := range results.([]interface{}) {
+		if name, ok := item.(map[string]interface{})["GroupName"].(string); ok {
+			groups = append(groups, name)
+		}
+	}
+	return groups, err
+}
+
+func (s *IamGroupService) iamGroupMembershipCall(action string, groupName string, userName string) ApiCall {
+	params := map[string]interface{}{
+		"GroupName": groupName,
+		"UserName":  userName,
+	}
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			if call.action == "AddUserToGroup" {
+				resp, err = conn.AddUserToGroup(call.param)
+			} else {
+				resp, err = conn.RemoveUserFromGroup(call.param)
+			}
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			if call.action == "RemoveUserFromGroup" && notFoundError(baseErr) {
+				return nil
+			}
+			return baseErr
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+func (s *IamGroupService) CreateIamGroupMembership(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	groupName := d.Get("group_name").(string)
+	for _, userName := range SchemaSetToStringSlice(d.Get("user_names")) {
+		apiProcess.PutCalls(s.iamGroupMembershipCall("AddUserToGroup", groupName, userName))
+	}
+	err = apiProcess.Run()
+	if err != nil {
+		return err
+	}
+	d.SetId(groupName)
+	return err
+}
+
+// ReadAndSetIamGroupMembership only keeps the users which are still in the group,
+// the users added to the group outside terraform are not managed.
+// The membership is removed from the state when the group doesn't exist.
+func (s *IamGroupService) ReadAndSetIamGroupMembership(d *schema.ResourceData) (err error) {
+	var userNames []string
+	groupName := d.Id()
+	if _, err = s.ReadGroup(map[string]interface{}{"GroupName": groupName}); err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for _, userName := range SchemaSetToStringSlice(d.Get("user_names")) {
+		groups, err := s.ReadIamGroupsForUser(userName)
+		if err != nil {
+			if notFoundError(err) {
+				continue
+			}
+			return err
+		}
+		for _, group := range groups {
+			if group == groupName {
+				userNames = append(userNames, userName)
+				break
+			}
+		}
+	}
+	_ = d.Set("group_name", groupName)
+	return d.Set("user_names", userNames)
+}
+
+func (s *IamGroupService) ModifyIamGroupMembership(d *schema.ResourceData) (err error) {
+	if !d.HasChange("user_names") {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	groupName := d.Get("group_name").(string)
+	oldUsers, newUsers := d.GetChange("user_names")
+	for _, userName := range SchemaSetToStringSlice(oldUsers.(*schema.Set).Difference(newUsers.(*schema.Set))) {
+		apiProcess.PutCalls(s.iamGroupMembershipCall("RemoveUserFromGroup", groupName, userName))
+	}
+	for _, userName := range SchemaSetToStringSlice(newUsers.(*schema.Set).Difference(oldUsers.(*schema.Set))) {
+		apiProcess.PutCalls(s.iamGroupMembershipCall("AddUserToGroup", groupName, userName))
+	}
+	return apiProcess.Run()
+}
+
+func (s *IamGroupService) RemoveIamGroupMembership(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	groupName := d.Get("group_name").(string)
+	for _, userName := range SchemaSetToStringSlice(d.Get("user_names")) {
+		apiProcess.PutCalls(s.iamGroupMembershipCall("RemoveUserFromGroup", groupName, userName))
+	}
+	return apiProcess.Run()
+}
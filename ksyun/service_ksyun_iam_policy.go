@@ -2,13 +2,18 @@ package ksyun
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
-	"sort"
-	"time"
 )
 
+const iamSystemPolicyKrnPrefix = "krn:ksc:iam::ksc:policy/"
+
 type IamPolicyService struct {
 	client *KsyunClient
 }
@@ -141,10 +146,141 @@ func (s *IamPolicyService) ReadAndSetIamPolicy(d *schema.ResourceData, r *schema
 	var data []interface{}
 	data, err = s.ReadPolicy(params)
 	SdkResponseAutoResourceData(d, r, data, nil)
+	if err != nil {
+		return
+	}
 
+	// refresh the document of the default version, so the changes outside terraform and the imported policy can be detected
+	for _, item := range data {
+		version := item.(map[string]interface{})
+		if fmt.Sprintf("%v", version["IsDefaultVersion"]) != "true" {
+			continue
+		}
+		var document string
+		document, err = s.ReadPolicyDocument(d.Get("policy_krn").(string), fmt.Sprintf("%v", version["VersionId"]))
+		if err != nil || document == "" {
+			return
+		}
+		err = d.Set("policy_document", document)
+		return
+	}
 	return
 }
 
+func (s *IamPolicyService) ReadPolicyDocument(policyKrn string, versionId string) (document string, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.iamconn
+	condition := map[string]interface{}{
+		"PolicyKrn": policyKrn,
+		"VersionId": versionId,
+	}
+	action := "GetPolicyVersion"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.GetPolicyVersion(&condition)
+	if err != nil {
+		return document, err
+	}
+	results, err = getSdkValue("GetPolicyVersionResult.PolicyVersion.Document", *resp)
+	if err != nil || results == nil {
+		return document, err
+	}
+	return decodeIamPolicyDocument(results.(string))
+}
+
+// ReadPolicies lists the policies of the scope, which is All, System or Local.
+func (s *IamPolicyService) ReadPolicies(scope string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.iamconn
+	action := "ListPolicies"
+	for page := 1; ; page++ {
+		condition := map[string]interface{}{
+			"Scope":    scope,
+			"Page":     page,
+			"MaxItems": 100,
+		}
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = conn.ListPolicies(&condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("ListPoliciesResult.Policies.member", *resp)
+		if err != nil || results == nil {
+			return data, err
+		}
+		policies := results.([]interface{})
+		data = append(data, policies...)
+		truncated, _ := getSdkValue("ListPoliciesResult.IsTruncated", *resp)
+		if len(policies) == 0 || fmt.Sprintf("%v", truncated) != "true" {
+			break
+		}
+	}
+	return data, err
+}
+
+func iamPolicyType(policyKrn string) string {
+	if strings.HasPrefix(policyKrn, iamSystemPolicyKrnPrefix) {
+		return "system"
+	}
+	return "custom"
+}
+
+func (s *IamPolicyService) ReadAndSetIamPolicies(d *schema.ResourceData, r *schema.Resource) (err error) {
+	scopes := map[string]string{
+		"all":    "All",
+		"system": "System",
+		"custom": "Local",
+	}
+	data, err := s.ReadPolicies(scopes[d.Get("policy_type").(string)])
+	if err != nil {
+		return err
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "PolicyName",
+		idFiled:     "Krn",
+		targetField: "policies",
+		extra: map[string]SdkResponseMapping{
+			"Krn": {
+				Field: "policy_krn",
+			},
+			"PolicyId": {
+				Field: "policy_id",
+				FieldRespFunc: func(i interface{}) interface{} {
+					return fmt.Sprintf("%v", i)
+				},
+			},
+		},
+	}, iamPoliciesTypePlugin)
+}
+
+func iamPoliciesTypePlugin(d *schema.ResourceData, item map[string]interface{}) (map[string]interface{}, bool, error) {
+	krn, _ := item["Krn"].(string)
+	item["PolicyType"] = iamPolicyType(krn)
+	return item, true, nil
+}
+
+// ReadPolicyKrnByName looks up the krn of the custom policy, which contains the account id.
+func (s *IamPolicyService) ReadPolicyKrnByName(policyName string) (policyKrn string, err error) {
+	policies, err := s.ReadPolicies("Local")
+	if err != nil {
+		return policyKrn, err
+	}
+	for _, item := range policies {
+		policy := item.(map[string]interface{})
+		if policy["PolicyName"] == policyName {
+			return fmt.Sprintf("%v", policy["Krn"]), err
+		}
+	}
+	return policyKrn, fmt.Errorf("policy %s not exist ", policyName)
+}
+
 func (s *IamPolicyService) UpdateIamPolicy(d *schema.ResourceData, r *schema.Resource) (err error) {
 	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
 
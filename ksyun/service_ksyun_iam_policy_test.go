@@ -0,0 +1,12 @@
+package ksyun
+
+import "testing"
+
+func TestIamPolicyType(t *testing.T) {
+	if v := iamPolicyType("krn:ksc:iam::ksc:policy/IAMReadOnlyAccess"); v != "system" {
+		t.Fatalf("expect system, got %s", v)
+	}
+	if v := iamPolicyType("krn:ksc:iam::2000012345:policy/tf-policy"); v != "custom" {
+		t.Fatalf("expect custom, got %s", v)
+	}
+}
@@ -2,6 +2,8 @@ package ksyun
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
@@ -56,15 +58,105 @@ func (s *IamProjectService) CreateIamProjectCall(d *schema.ResourceData, r *sche
 }
 
 func (s *IamProjectService) ReadAndSetIamProject(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadIamProjectById(d.Id())
+	if err != nil {
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, iamProjectRespExtra())
+	return err
+}
 
-	params := map[string]interface{}{}
-	params["ProjectName"] = d.Get("project_name")
+func iamProjectRespExtra() map[string]SdkResponseMapping {
+	toString := func(i interface{}) interface{} {
+		return fmt.Sprintf("%v", i)
+	}
+	return map[string]SdkResponseMapping{
+		"ProjectId": {
+			Field:         "project_id",
+			FieldRespFunc: toString,
+		},
+		"Status": {
+			Field:         "status",
+			FieldRespFunc: toString,
+		},
+	}
+}
+
+// ReadIamProjectById looks the project up in the whole project list, GetAccountAllProjectList can't filter by id.
+// The disabled project (status 0) is regarded as not exist.
+func (s *IamProjectService) ReadIamProjectById(projectId string) (data map[string]interface{}, err error) {
+	projects, err := s.ReadProjects(map[string]interface{}{})
+	if err != nil {
+		return data, err
+	}
+	for _, item := range projects {
+		project := item.(map[string]interface{})
+		if fmt.Sprintf("%v", project["ProjectId"]) == projectId {
+			// a destroyed project is only disabled, so it is treated as not exist
+			if fmt.Sprintf("%v", project["Status"]) == "0" {
+				return data, fmt.Errorf("project %s is disabled, not exist ", projectId)
+			}
+			return project, err
+		}
+	}
+	return data, fmt.Errorf("project %s not exist ", projectId)
+}
 
-	var data []interface{}
-	data, err = s.ReadProject(params)
-	SdkResponseAutoResourceData(d, r, data, nil)
+func (s *IamProjectService) ModifyIamProject(d *schema.ResourceData, r *schema.Resource) (err error) {
+	if !d.HasChange("project_name") && !d.HasChange("project_desc") {
+		return err
+	}
+	params := map[string]interface{}{
+		"ProjectId":   d.Id(),
+		"ProjectName": d.Get("project_name"),
+		"ProjectDesc": d.Get("project_desc"),
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "UpdateProjectInfo",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.UpdateProjectInfo(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	})
+	return apiProcess.Run()
+}
 
-	return
+// DeleteIamProject disables the project, the IAM api doesn't provide a way to remove a project.
+func (s *IamProjectService) DeleteIamProject(d *schema.ResourceData) (err error) {
+	params := map[string]interface{}{
+		"ProjectId": d.Id(),
+		"Status":    0,
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "UpdateProjectStatus",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.UpdateProjectStatus(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			if notFoundError(baseErr) {
+				return nil
+			}
+			return baseErr
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	})
+	return apiProcess.Run()
 }
 
 func (s *IamProjectService) ReadProject(condition map[string]interface{}) (data []interface{}, err error) {
@@ -133,11 +225,8 @@ func (s *IamProjectService) ReadProjects(condition map[string]interface{}) (data
 }
 
 func (s *IamProjectService) ReadAndSetIamProjects(d *schema.ResourceData, r *schema.Resource) (err error) {
-	req, err := mergeDataSourcesReq(d, r, nil)
+	req := map[string]interface{}{}
 	logger.Debug(logger.ReqFormat, "GetAccountAllProjectList", req)
-	if err != nil {
-		return err
-	}
 	data, err := s.ReadProjects(req)
 	if err != nil {
 		return err
@@ -145,7 +234,165 @@ func (s *IamProjectService) ReadAndSetIamProjects(d *schema.ResourceData, r *sch
 
 	return mergeDataSourcesResp(d, r, ksyunDataSource{
 		collection:  data,
+		nameField:   "ProjectName",
+		idFiled:     "ProjectId",
 		targetField: "projects",
-		extra:       map[string]SdkResponseMapping{},
+		extra:       iamProjectRespExtra(),
+	}, iamProjectIdsPlugin)
+}
+
+// iamProjectIdsPlugin filters the projects by ids, and turns the numeric ProjectId into string.
+func iamProjectIdsPlugin(d *schema.ResourceData, item map[string]interface{}) (map[string]interface{}, bool, error) {
+	projectId := fmt.Sprintf("%v", item["ProjectId"])
+	item["ProjectId"] = projectId
+	if ids, ok := d.GetOk("ids"); ok && ids.(*schema.Set).Len() > 0 {
+		if !ids.(*schema.Set).Contains(projectId) {
+			return nil, true, nil
+		}
+	}
+	return item, true, nil
+}
+
+func iamProjectIdentityTypeReq(identityType string) int {
+	if identityType == "role" {
+		return 2
+	}
+	return 1
+}
+
+// readIamProjectIdentityId resolves the id of the user or role which is assigned to the project.
+func (s *IamProjectService) readIamProjectIdentityId(d *schema.ResourceData) (identityId string, err error) {
+	var (
+		data []interface{}
+		path string
+	)
+	name := d.Get("identity_name").(string)
+	if d.Get("identity_type").(string) == "role" {
+		roleService := IamRoleService{s.client}
+		data, err = roleService.ReadRole(map[string]interface{}{"RoleName": name})
+		path = "RoleId"
+	} else {
+		userService := IamUserService{s.client}
+		data, err = userService.ReadUser(map[string]interface{}{"UserName": name})
+		path = "UserId"
+	}
+	if err != nil {
+		return identityId, err
+	}
+	for _, item := range data {
+		if v, ok := item.(map[string]interface{})[path]; ok && v != nil {
+			return fmt.Sprintf("%v", v), err
+		}
+	}
+	return identityId, fmt.Errorf("%s %s not exist ", d.Get("identity_type"), name)
+}
+
+func (s *IamProjectService) ReadIamProjectMembers(projectId string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.iamconn
+	condition := map[string]interface{}{
+		"ProjectId": projectId,
+	}
+	action := "ListProjectMember"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.ListProjectMember(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("ListProjectMemberResult.MemberList", *resp)
+	if err != nil || results == nil {
+		return data, err
+	}
+	data = results.([]interface{})
+	return data, err
+}
+
+func (s *IamProjectService) ReadIamProjectMember(d *schema.ResourceData) (data map[string]interface{}, err error) {
+	identityId, err := s.readIamProjectIdentityId(d)
+	if err != nil {
+		return data, err
+	}
+	members, err := s.ReadIamProjectMembers(d.Get("project_id").(string))
+	if err != nil {
+		return data, err
+	}
+	for _, item := range members {
+		member := item.(map[string]interface{})
+		if fmt.Sprintf("%v", member["IdentityId"]) == identityId {
+			return member, err
+		}
+	}
+	return data, fmt.Errorf("%s %s of project %s not exist ", d.Get("identity_type"), d.Get("identity_name"), d.Get("project_id"))
+}
+
+func (s *IamProjectService) ReadAndSetIamProjectMember(d *schema.ResourceData) (err error) {
+	data, err := s.ReadIamProjectMember(d)
+	if err != nil {
+		return err
+	}
+	return d.Set("member_id", fmt.Sprintf("%v", data["MemberId"]))
+}
+
+func (s *IamProjectService) CreateIamProjectMember(d *schema.ResourceData) (err error) {
+	identityId, err := s.readIamProjectIdentityId(d)
+	if err != nil {
+		return err
+	}
+	params := map[string]interface{}{
+		"ProjectId":    d.Get("project_id"),
+		"IdentityType": iamProjectIdentityTypeReq(d.Get("identity_type").(string)),
+		"IdentityId":   identityId,
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "AddProjectMember",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.AddProjectMember(call.param)
+			if err != nil {
+				return resp, err
+			}
+			d.SetId(AssembleIds(d.Get("project_id").(string), d.Get("identity_type").(string), d.Get("identity_name").(string)))
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	})
+	return apiProcess.Run()
+}
+
+func (s *IamProjectService) RemoveIamProjectMember(d *schema.ResourceData) (err error) {
+	params := map[string]interface{}{
+		"ProjectId": d.Get("project_id"),
+		"MemberId":  d.Get("member_id"),
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "DeleteProjectMember",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.DeleteProjectMember(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			if notFoundError(baseErr) {
+				return nil
+			}
+			return baseErr
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
 	})
+	return apiProcess.Run()
 }
@@ -382,3 +382,27 @@ func (s *IamRelationPolicyService) ReadRelationPolicy(relationType int, name str
 
 	return data, err
 }
+
+// ReadRelationAccountId reads the account id from the krn of the user or the role,
+// which is the id of ksyun_iam_relation_policy.
+func (s *IamRelationPolicyService) ReadRelationAccountId(relationType int, name string) (accountId string, err error) {
+	var data []interface{}
+	if relationType == 1 {
+		userService := IamUserService{s.client}
+		data, err = userService.ReadUser(map[string]interface{}{"UserName": name})
+	} else {
+		roleService := IamRoleService{s.client}
+		data, err = roleService.ReadRole(map[string]interface{}{"RoleName": name})
+	}
+	if err != nil {
+		return accountId, err
+	}
+	for _, item := range data {
+		krn, _ := item.(map[string]interface{})["Krn"].(string)
+		match := regexp.MustCompile(`::(\d+):`).FindStringSubmatch(krn)
+		if len(match) == 2 {
+			return match[1], err
+		}
+	}
+	return accountId, fmt.Errorf("account id of %s not exist ", name)
+}
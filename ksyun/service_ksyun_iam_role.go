@@ -2,6 +2,7 @@ package ksyun
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"time"
 
@@ -51,7 +52,11 @@ func (s *IamRoleService) CreateIamRole(d *schema.ResourceData, r *schema.Resourc
 }
 
 func (s *IamRoleService) CreateIamRoleCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
-	req, err := SdkRequestAutoMapping(d, r, false, nil, nil)
+	req, err := SdkRequestAutoMapping(d, r, false, nil, map[string]SdkRequestMapping{
+		"trust_policy_document": {
+			Field: "AssumeRolePolicyDocument",
+		},
+	})
 	if err != nil {
 		return callback, err
 	}
@@ -65,11 +70,83 @@ func (s *IamRoleService) ReadAndSetIamRole(d *schema.ResourceData, r *schema.Res
 
 	var data []interface{}
 	data, err = s.ReadRole(params)
-	SdkResponseAutoResourceData(d, r, data, nil)
+	if err != nil {
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, iamRoleRespExtra())
 
 	return
 }
 
+func iamRoleRespExtra() map[string]SdkResponseMapping {
+	return map[string]SdkResponseMapping{
+		"RoleId": {
+			Field: "role_id",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+		"AssumeRolePolicyDocument": {
+			Field: "trust_policy_document",
+			FieldRespFunc: func(i interface{}) interface{} {
+				document, err := decodeIamPolicyDocument(fmt.Sprintf("%v", i))
+				if err != nil {
+					return i
+				}
+				return document
+			},
+		},
+	}
+}
+
+func (s *IamRoleService) iamRoleCall(action string, params map[string]interface{}, execute func(*map[string]interface{}) (*map[string]interface{}, error)) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = execute(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+// iamOpenApiCall calls the IAM actions which have not been generated into ksc-sdk-go.
+func (s *IamRoleService) iamOpenApiCall(action string) func(*map[string]interface{}) (*map[string]interface{}, error) {
+	return func(params *map[string]interface{}) (*map[string]interface{}, error) {
+		return ksyunOpenApiCall(s.client.iamconn.Client, action, params)
+	}
+}
+
+func (s *IamRoleService) ModifyIamRole(d *schema.ResourceData) (err error) {
+	conn := s.client.iamconn
+	roleName := d.Get("role_name")
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	if d.HasChange("trust_accounts") && d.Get("trust_accounts").(string) != "" {
+		apiProcess.PutCalls(s.iamRoleCall("UpdateRoleTrustAccounts", map[string]interface{}{
+			"RoleName":      roleName,
+			"TrustAccounts": d.Get("trust_accounts"),
+		}, conn.UpdateRoleTrustAccounts))
+	}
+	if d.HasChange("trust_policy_document") && d.Get("trust_policy_document").(string) != "" {
+		apiProcess.PutCalls(s.iamRoleCall("UpdateAssumeRolePolicy", map[string]interface{}{
+			"RoleName":       roleName,
+			"PolicyDocument": d.Get("trust_policy_document"),
+		}, s.iamOpenApiCall("UpdateAssumeRolePolicy")))
+	}
+	if d.HasChange("max_session_duration") {
+		apiProcess.PutCalls(s.iamRoleCall("UpdateRole", map[string]interface{}{
+			"RoleName":           roleName,
+			"MaxSessionDuration": d.Get("max_session_duration"),
+		}, conn.UpdateRole))
+	}
+	return apiProcess.Run()
+}
+
 func (s *IamRoleService) ReadRole(condition map[string]interface{}) (data []interface{}, err error) {
 	var (
 		resp    *map[string]interface{}
@@ -194,3 +271,130 @@ func (s *IamRoleService) ReadAndSetIamRoles(d *schema.ResourceData, r *schema.Re
 		extra:       map[string]SdkResponseMapping{},
 	})
 }
+
+func (s *IamRoleService) ReadIamInstanceProfile(name string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	condition := map[string]interface{}{
+		"InstanceProfileName": name,
+	}
+	action := "GetInstanceProfile"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = s.iamOpenApiCall(action)(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("GetInstanceProfileResult.InstanceProfile", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, ok := results.(map[string]interface{})
+	if !ok {
+		return data, fmt.Errorf("instance profile %s is not exist", name)
+	}
+	return data, err
+}
+
+// iamInstanceProfileRoleName returns the role of the instance profile, which contains one role at most.
+func iamInstanceProfileRoleName(profile map[string]interface{}) string {
+	roles, _ := getSdkValue("Roles.member", profile)
+	if members, ok := roles.([]interface{}); ok {
+		for _, member := range members {
+			if role, ok := member.(map[string]interface{}); ok {
+				return fmt.Sprintf("%v", role["RoleName"])
+			}
+		}
+	}
+	return ""
+}
+
+func (s *IamRoleService) ReadAndSetIamInstanceProfile(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadIamInstanceProfile(d.Id())
+	if err != nil {
+		return err
+	}
+	data["RoleName"] = iamInstanceProfileRoleName(data)
+	SdkResponseAutoResourceData(d, r, data, map[string]SdkResponseMapping{
+		"InstanceProfileId": {
+			Field: "instance_profile_id",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i)
+			},
+		},
+	})
+	return err
+}
+
+func (s *IamRoleService) iamInstanceProfileRoleCall(action string, profile string, role string) ApiCall {
+	return s.iamRoleCall(action, map[string]interface{}{
+		"InstanceProfileName": profile,
+		"RoleName":            role,
+	}, s.iamOpenApiCall(action))
+}
+
+func (s *IamRoleService) CreateIamInstanceProfile(d *schema.ResourceData) (err error) {
+	name := d.Get("instance_profile_name").(string)
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	createCall := s.iamRoleCall("CreateInstanceProfile", map[string]interface{}{
+		"InstanceProfileName": name,
+	}, s.iamOpenApiCall("CreateInstanceProfile"))
+	createCall.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		d.SetId(name)
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+	apiProcess.PutCalls(s.iamInstanceProfileRoleCall("AddRoleToInstanceProfile", name, d.Get("role_name").(string)))
+	return apiProcess.Run()
+}
+
+func (s *IamRoleService) ModifyIamInstanceProfile(d *schema.ResourceData) (err error) {
+	if !d.HasChange("role_name") {
+		return err
+	}
+	o, n := d.GetChange("role_name")
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	if o.(string) != "" {
+		apiProcess.PutCalls(s.iamInstanceProfileRoleCall("RemoveRoleFromInstanceProfile", d.Id(), o.(string)))
+	}
+	apiProcess.PutCalls(s.iamInstanceProfileRoleCall("AddRoleToInstanceProfile", d.Id(), n.(string)))
+	return apiProcess.Run()
+}
+
+func (s *IamRoleService) RemoveIamInstanceProfile(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	ignoreNotFound := func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		if notFoundError(baseErr) {
+			return nil
+		}
+		return baseErr
+	}
+	if role := d.Get("role_name").(string); role != "" {
+		removeCall := s.iamInstanceProfileRoleCall("RemoveRoleFromInstanceProfile", d.Id(), role)
+		removeCall.callError = ignoreNotFound
+		apiProcess.PutCalls(removeCall)
+	}
+	deleteCall := s.iamRoleCall("DeleteInstanceProfile", map[string]interface{}{
+		"InstanceProfileName": d.Id(),
+	}, s.iamOpenApiCall("DeleteInstanceProfile"))
+	deleteCall.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		return resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if notFoundError(baseErr) {
+				return nil
+			}
+			if isExpectError(baseErr, []string{"DeleteConflict"}) {
+				return resource.NonRetryableError(baseErr)
+			}
+			_, callErr := call.executeCall(d, client, call)
+			if callErr == nil {
+				return nil
+			}
+			baseErr = callErr
+			return resource.RetryableError(callErr)
+		})
+	}
+	apiProcess.PutCalls(deleteCall)
+	return apiProcess.Run()
+}
@@ -2,9 +2,12 @@ package ksyun
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/encryption"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
@@ -200,3 +203,240 @@ func (s *IamUserService) ReadAndSetIamUsers(d *schema.ResourceData, r *schema.Re
 		extra:       map[string]SdkResponseMapping{},
 	})
 }
+
+// iamLogParams hides the password of the request in the log.
+func iamLogParams(params map[string]interface{}) map[string]interface{} {
+	if _, ok := params["Password"]; !ok {
+		return params
+	}
+	result := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		result[k] = v
+	}
+	result["Password"] = "******"
+	return result
+}
+
+func (s *IamUserService) iamUserCall(action string, params map[string]interface{}, execute func(*map[string]interface{}) (*map[string]interface{}, error)) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.ReqFormat, call.action, iamLogParams(*(call.param)))
+			resp, err = execute(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, iamLogParams(*(call.param)), *resp)
+			return err
+		},
+	}
+}
+
+func (s *IamUserService) ReadIamAccessKeys(userName string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.iamconn
+	condition := map[string]interface{}{
+		"UserName": userName,
+	}
+	action := "ListAccessKeys"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.ListAccessKeys(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("ListAccessKeyResult.AccessKeyMetadata.member", *resp)
+	if err != nil || results == nil {
+		return data, err
+	}
+	data = results.([]interface{})
+	return data, err
+}
+
+func (s *IamUserService) ReadIamAccessKey(d *schema.ResourceData) (data map[string]interface{}, err error) {
+	results, err := s.ReadIamAccessKeys(d.Get("user_name").(string))
+	if err != nil {
+		return data, err
+	}
+	for _, item := range results {
+		accessKey := item.(map[string]interface{})
+		if accessKey["AccessKeyId"] == d.Id() {
+			return accessKey, err
+		}
+	}
+	return data, fmt.Errorf("access key %s not exist ", d.Id())
+}
+
+func (s *IamUserService) ReadAndSetIamAccessKey(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadIamAccessKey(d)
+	if err != nil {
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+// CreateIamAccessKey keeps the secret in the state only when no pgp_key is given,
+// otherwise only the encrypted secret is kept.
+func (s *IamUserService) CreateIamAccessKey(d *schema.ResourceData) (err error) {
+	conn := s.client.iamconn
+	params := map[string]interface{}{
+		"UserName": d.Get("user_name"),
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	call := s.iamUserCall("CreateAccessKey", params, conn.CreateAccessKey)
+	call.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		// the response is not logged as it contains the secret
+		accessKeyId, err := getSdkValue("CreateAccessKeyResult.AccessKey.AccessKeyId", *resp)
+		if err != nil || accessKeyId == nil {
+			return fmt.Errorf("access key id is missing in the response of %s", call.action)
+		}
+		secret, _ := getSdkValue("CreateAccessKeyResult.AccessKey.SecretAccessKey", *resp)
+		d.SetId(accessKeyId.(string))
+		if v, ok := d.GetOk("pgp_key"); ok {
+			encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
+			if err != nil {
+				return err
+			}
+			fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, fmt.Sprintf("%v", secret), "IAM Access Key Secret")
+			if err != nil {
+				return err
+			}
+			_ = d.Set("key_fingerprint", fingerprint)
+			return d.Set("encrypted_secret", encrypted)
+		}
+		return d.Set("secret", fmt.Sprintf("%v", secret))
+	}
+	apiProcess.PutCalls(call)
+	err = apiProcess.Run()
+	if err != nil {
+		return err
+	}
+	if d.Get("status").(string) == "Inactive" {
+		return s.ModifyIamAccessKey(d)
+	}
+	return err
+}
+
+func (s *IamUserService) ModifyIamAccessKey(d *schema.ResourceData) (err error) {
+	conn := s.client.iamconn
+	params := map[string]interface{}{
+		"UserName":    d.Get("user_name"),
+		"AccessKeyId": d.Id(),
+		"Status":      d.Get("status"),
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(s.iamUserCall("UpdateAccessKey", params, conn.UpdateAccessKey))
+	return apiProcess.Run()
+}
+
+func (s *IamUserService) RemoveIamAccessKey(d *schema.ResourceData) (err error) {
+	conn := s.client.iamconn
+	params := map[string]interface{}{
+		"UserName":    d.Get("user_name"),
+		"AccessKeyId": d.Id(),
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	call := s.iamUserCall("DeleteAccessKey", params, conn.DeleteAccessKey)
+	call.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		if notFoundError(baseErr) {
+			return nil
+		}
+		return baseErr
+	}
+	apiProcess.PutCalls(call)
+	return apiProcess.Run()
+}
+
+func (s *IamUserService) ReadIamLoginProfile(userName string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.iamconn
+	condition := map[string]interface{}{
+		"UserName": userName,
+	}
+	action := "GetLoginProfile"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.GetLoginProfile(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("GetLoginProfileResult.LoginProfile", *resp)
+	if err != nil {
+		return data, err
+	}
+	if results == nil {
+		return data, fmt.Errorf("login profile of user %s not exist ", userName)
+	}
+	data = results.(map[string]interface{})
+	return data, err
+}
+
+func (s *IamUserService) ReadAndSetIamLoginProfile(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadIamLoginProfile(d.Id())
+	if err != nil {
+		return err
+	}
+	_ = d.Set("user_name", d.Id())
+	SdkResponseAutoResourceData(d, r, data, map[string]SdkResponseMapping{
+		"PasswordResetRequired": {
+			Field: "password_reset_required",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return fmt.Sprintf("%v", i) == "true" || fmt.Sprintf("%v", i) == "1"
+			},
+		},
+	})
+	return err
+}
+
+func (s *IamUserService) iamLoginProfileParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":              d.Get("user_name"),
+		"Password":              d.Get("password"),
+		"PasswordResetRequired": d.Get("password_reset_required"),
+	}
+}
+
+func (s *IamUserService) CreateIamLoginProfile(d *schema.ResourceData) (err error) {
+	conn := s.client.iamconn
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(s.iamUserCall("CreateLoginProfile", s.iamLoginProfileParams(d), conn.CreateLoginProfile))
+	err = apiProcess.Run()
+	if err != nil {
+		return err
+	}
+	d.SetId(d.Get("user_name").(string))
+	return err
+}
+
+func (s *IamUserService) ModifyIamLoginProfile(d *schema.ResourceData) (err error) {
+	if !d.HasChange("password") && !d.HasChange("password_reset_required") {
+		return err
+	}
+	conn := s.client.iamconn
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	apiProcess.PutCalls(s.iamUserCall("UpdateLoginProfile", s.iamLoginProfileParams(d), conn.UpdateLoginProfile))
+	return apiProcess.Run()
+}
+
+func (s *IamUserService) RemoveIamLoginProfile(d *schema.ResourceData) (err error) {
+	conn := s.client.iamconn
+	params := map[string]interface{}{
+		"UserName": d.Id(),
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	call := s.iamUserCall("DeleteLoginProfile", params, conn.DeleteLoginProfile)
+	call.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		if notFoundError(baseErr) {
+			return nil
+		}
+		return baseErr
+	}
+	apiProcess.PutCalls(call)
+	return apiProcess.Run()
+}
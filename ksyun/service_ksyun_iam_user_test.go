@@ -0,0 +1,17 @@
+package ksyun
+
+import "testing"
+
+func TestIamLogParams(t *testing.T) {
+	params := map[string]interface{}{
+		"UserName": "tf-user",
+		"Password": "Secret@123",
+	}
+	logParams := iamLogParams(params)
+	if logParams["Password"] != "******" || logParams["UserName"] != "tf-user" {
+		t.Fatalf("unexpected log params %v", logParams)
+	}
+	if params["Password"] != "Secret@123" {
+		t.Fatal("the request params should not be changed")
+	}
+}
@@ -1,6 +1,12 @@
 package ksyun
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
@@ -97,3 +103,418 @@ func (s *ImageService) ReadAndSetKecImages(d *schema.ResourceData, r *schema.Res
 		return result, flag, err
 	})
 }
+
+func kecImageResponseMapping() map[string]SdkResponseMapping {
+	return map[string]SdkResponseMapping{
+		"Name": {
+			Field: "image_name",
+		},
+	}
+}
+
+func (s *ImageService) ReadKecImage(d *schema.ResourceData, imageId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if imageId == "" {
+		imageId = d.Id()
+	}
+	req := map[string]interface{}{
+		"ImageId.1": imageId,
+	}
+	results, err = s.readKecImages(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Image %s not exist ", imageId)
+	}
+	return data, err
+}
+
+func (s *ImageService) ReadAndSetKecImage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKecImage(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading image %q, %s", d.Id(), callErr))
+			}
+		}
+		SdkResponseAutoResourceData(d, r, data, kecImageResponseMapping())
+		return nil
+	})
+}
+
+func (s *ImageService) CreateKecImage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKecImageCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *ImageService) ModifyKecImage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	modifyCall, err := s.modifyKecImageCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(modifyCall)
+
+	return apiProcess.Run()
+}
+
+func (s *ImageService) RemoveKecImage(d *schema.ResourceData) (err error) {
+	err = s.checkKecImageNotInUse(d.Id())
+	if err != nil {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(s.removeKecImageCall(d))
+	return apiProcess.Run()
+}
+
+// checkKecImageNotInUse prevents the image from being deleted when scaling configurations still launch instances with it.
+func (s *ImageService) checkKecImageNotInUse(imageId string) (err error) {
+	var configIds []string
+	configs, err := pageQuery(nil, "MaxResults", "Marker", 100, 1, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.kecconn
+		action := "DescribeScalingConfiguration"
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err := conn.DescribeScalingConfiguration(&condition)
+		if err != nil {
+			return nil, err
+		}
+		results, err := getSdkValue("ScalingConfigurationSet", *resp)
+		if err != nil {
+			return nil, err
+		}
+		return results.([]interface{}), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error on checking the scaling configurations of image %q, %s", imageId, err)
+	}
+	for _, v := range configs {
+		config := v.(map[string]interface{})
+		if config["ImageId"] == imageId {
+			configIds = append(configIds, fmt.Sprintf("%v", config["ScalingConfigurationId"]))
+		}
+	}
+	if len(configIds) > 0 {
+		return fmt.Errorf("image %q is still used by scaling configurations [%s]", imageId, strings.Join(configIds, ", "))
+	}
+	return nil
+}
+
+func (s *ImageService) createKecImageCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"image_name": {
+			mapping: "Name",
+		},
+		"instance_id": {},
+		"snapshot_id": {},
+		"data_disk_ids": {
+			Type: TransformWithN,
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateImage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CreateImage(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("ImageId", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			return s.checkKecImageState(d, d.Id(), []string{"active"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *ImageService) modifyKecImageCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("image_name") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"ImageId": d.Id(),
+		"Name":    d.Get("image_name"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyImageAttribute",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.ModifyImageAttribute(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *ImageService) removeKecImageCall(d *schema.ResourceData) (callback ApiCall) {
+	removeReq := map[string]interface{}{
+		"ImageId.1": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "RemoveImages",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.RemoveImages(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			imageService := ImageService{client}
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := imageService.ReadKecImage(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading image when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback
+}
+
+func (s *ImageService) checkKecImageState(d *schema.ResourceData, imageId string, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     target,
+		Refresh:    s.kecImageStateRefreshFunc(d, imageId, []string{"error"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 1 * time.Minute,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *ImageService) kecImageStateRefreshFunc(d *schema.ResourceData, imageId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadKecImage(d, imageId)
+		if err != nil {
+			return nil, "", err
+		}
+		status, err := getSdkValue("ImageState", data)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, v := range failStates {
+			if v == status.(string) {
+				return nil, "", fmt.Errorf("image status error, status:%v", status)
+			}
+		}
+		return data, status.(string), nil
+	}
+}
+
+// ReadAndSetKecImageCopy reads the copied image from the destination region.
+func (s *ImageService) ReadAndSetKecImageCopy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	client, err := s.client.WithRegion(d.Get("destination_region").(string))
+	if err != nil {
+		return err
+	}
+	imageService := ImageService{client}
+	return imageService.ReadAndSetKecImage(d, r)
+}
+
+func (s *ImageService) CreateKecImageCopy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(s.copyKecImageCall(d))
+	return apiProcess.Run()
+}
+
+func (s *ImageService) ModifyKecImageCopy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	client, err := s.client.WithRegion(d.Get("destination_region").(string))
+	if err != nil {
+		return err
+	}
+	imageService := ImageService{client}
+	return imageService.ModifyKecImage(d, r)
+}
+
+func (s *ImageService) RemoveKecImageCopy(d *schema.ResourceData) (err error) {
+	client, err := s.client.WithRegion(d.Get("destination_region").(string))
+	if err != nil {
+		return err
+	}
+	imageService := ImageService{client}
+	return imageService.RemoveKecImage(d)
+}
+
+func (s *ImageService) copyKecImageCall(d *schema.ResourceData) (callback ApiCall) {
+	params := map[string]interface{}{
+		"ImageId.1":           d.Get("source_image_id"),
+		"DestinationRegion.1": d.Get("destination_region"),
+	}
+	if v, ok := d.GetOk("image_name"); ok {
+		params["DestinationImageName"] = v
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "CopyImage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CopyImage(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("ImageIdSet.0.ImageId", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			regionClient, err := client.WithRegion(d.Get("destination_region").(string))
+			if err != nil {
+				return err
+			}
+			imageService := ImageService{regionClient}
+			return imageService.checkKecImageState(d, d.Id(), []string{"active"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback
+}
+
+func (s *ImageService) ReadKecImageSharePermission(imageId string) (accountIds []interface{}, err error) {
+	conn := s.client.kecconn
+	req := map[string]interface{}{
+		"ImageId": imageId,
+	}
+	action := "DescribeImageSharePermission"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := conn.DescribeImageSharePermission(&req)
+	if err != nil {
+		return accountIds, err
+	}
+	results, err := getSdkValue("AccountIds", *resp)
+	if err != nil {
+		return accountIds, nil
+	}
+	if ids, ok := results.([]interface{}); ok {
+		for _, id := range ids {
+			if m, ok := id.(map[string]interface{}); ok {
+				accountIds = append(accountIds, m["AccountId"])
+			} else {
+				accountIds = append(accountIds, id)
+			}
+		}
+	}
+	return accountIds, err
+}
+
+func (s *ImageService) ReadAndSetKecImageSharePermission(d *schema.ResourceData, r *schema.Resource) (err error) {
+	_, err = s.ReadKecImage(d, d.Id())
+	if err != nil {
+		return err
+	}
+	accountIds, err := s.ReadKecImageSharePermission(d.Id())
+	if err != nil {
+		return err
+	}
+	_ = d.Set("image_id", d.Id())
+	return d.Set("account_ids", accountIds)
+}
+
+func (s *ImageService) CreateKecImageSharePermission(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(s.modifyKecImageSharePermissionCall(d.Get("image_id").(string), "share", d.Get("account_ids").(*schema.Set).List()))
+	return apiProcess.Run()
+}
+
+func (s *ImageService) ModifyKecImageSharePermission(d *schema.ResourceData) (err error) {
+	if !d.HasChange("account_ids") {
+		return err
+	}
+	o, n := d.GetChange("account_ids")
+	add := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+	remove := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(s.modifyKecImageSharePermissionCall(d.Get("image_id").(string), "cancel", remove))
+	apiProcess.PutCalls(s.modifyKecImageSharePermissionCall(d.Get("image_id").(string), "share", add))
+	return apiProcess.Run()
+}
+
+func (s *ImageService) RemoveKecImageSharePermission(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(s.modifyKecImageSharePermissionCall(d.Id(), "cancel", d.Get("account_ids").(*schema.Set).List()))
+	return apiProcess.Run()
+}
+
+func (s *ImageService) modifyKecImageSharePermissionCall(imageId string, permission string, accountIds []interface{}) (callback ApiCall) {
+	if len(accountIds) == 0 {
+		return callback
+	}
+	params := map[string]interface{}{
+		"ImageId":    imageId,
+		"Permission": permission,
+	}
+	for i, id := range accountIds {
+		params[fmt.Sprintf("AccountId.%d", i+1)] = id
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyImageSharePermission",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.ModifyImageSharePermission(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			d.SetId(imageId)
+			return err
+		},
+	}
+	return callback
+}
@@ -641,6 +641,9 @@ func (s *KecService) createKecInstanceCommon(d *schema.ResourceData, r *schema.R
 	// createReq, err := SdkRequestAutoMapping(d, resource, false, transform, nil, SdkReqParameter{
 	//	onlyTransform: false,
 	// })
+	if err = checkKecSpotParams(d); err != nil {
+		return callback, err
+	}
 	createReq, err := transKecInstanceParams(d, r)
 	if err != nil {
 		return callback, err
@@ -656,9 +659,13 @@ func (s *KecService) createKecInstanceCommon(d *schema.ResourceData, r *schema.R
 		param:  &createReq,
 		action: "RunInstances",
 		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
-			conn := client.kecconn
 			logger.Debug(logger.RespFormat, call.action, *(call.param))
-			resp, err = conn.RunInstances(call.param)
+			// the instance placed on a dedicated host must be created by the dedicated api
+			if _, ok := (*call.param)["DedicatedHostId"]; ok {
+				resp, err = client.dedicatedconn.RunInstances(call.param)
+			} else {
+				resp, err = client.kecconn.RunInstances(call.param)
+			}
 			logger.Debug(logger.RespFormat, call.action, "runinstances", err)
 			return resp, err
 		},
@@ -0,0 +1,689 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type KafkaService struct {
+	client *KsyunClient
+}
+
+// kafkaApiCall returns an ApiCall which calls the kafka open api, the sdk doesn't provide the kafka client.
+func kafkaApiCall(action string, params map[string]interface{}) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(client.kafkaconn, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+func (s *KafkaService) readKafkaList(action string, path string, condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "Limit", "Offset", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = ksyunOpenApiCall(s.client.kafkaconn, action, &condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue(path, *resp)
+		if err != nil || results == nil {
+			return []interface{}{}, err
+		}
+		return If2Slice(results)
+	})
+}
+
+// start kafka instance
+
+func (s *KafkaService) ReadKafkaInstances(condition map[string]interface{}) (data []interface{}, err error) {
+	return s.readKafkaList("DescribeInstances", "Data.Instances", condition)
+}
+
+func (s *KafkaService) ReadKafkaInstance(d *schema.ResourceData, instanceId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if instanceId == "" {
+		instanceId = d.Id()
+	}
+	req := map[string]interface{}{
+		"InstanceId.1": instanceId,
+	}
+	results, err = s.ReadKafkaInstances(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("kafka instance %s not exist ", instanceId)
+	}
+	return data, err
+}
+
+func (s *KafkaService) ReadKafkaSecurityRules(instanceId string) (cidrs []string, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"InstanceId": instanceId,
+	}
+	action := "DescribeSecurityGroupRules"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(s.client.kafkaconn, action, &req)
+	if err != nil {
+		return cidrs, err
+	}
+	results, err = getSdkValue("Data", *resp)
+	if err != nil {
+		return cidrs, err
+	}
+	rules, _ := results.([]interface{})
+	for _, rule := range rules {
+		if r, ok := rule.(map[string]interface{}); ok {
+			cidrs = append(cidrs, r["Cidr"].(string))
+		}
+	}
+	return cidrs, err
+}
+
+func (s *KafkaService) ReadAndSetKafkaInstance(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKafkaInstance(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading kafka instance %q, %s", d.Id(), callErr))
+			}
+		}
+		extra := map[string]SdkResponseMapping{
+			"AvailabilityZone": {
+				Field:         "availability_zone",
+				FieldRespFunc: kafkaAvailabilityZoneResp,
+			},
+			"Status": {
+				Field: "status",
+				FieldRespFunc: func(i interface{}) interface{} {
+					return fmt.Sprintf("%v", i)
+				},
+			},
+			"ProjectId": {
+				Field: "project_id",
+				FieldRespFunc: func(i interface{}) interface{} {
+					return fmt.Sprintf("%v", i)
+				},
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+
+		cidrs, callErr := s.ReadKafkaSecurityRules(d.Id())
+		if callErr != nil {
+			return resource.NonRetryableError(callErr)
+		}
+		callErr = d.Set("cidrs", cidrs)
+		if callErr != nil {
+			return resource.NonRetryableError(callErr)
+		}
+		return nil
+	})
+}
+
+// kafkaAvailabilityZoneResp converts the zones of the instance, which are returned as a comma separated string.
+func kafkaAvailabilityZoneResp(i interface{}) interface{} {
+	if v, ok := i.(string); ok {
+		var zones []interface{}
+		for _, zone := range strings.Split(v, ",") {
+			zones = append(zones, zone)
+		}
+		return zones
+	}
+	return i
+}
+
+func (s *KafkaService) ReadAndSetKafkaInstances(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"ids": {
+			mapping: "InstanceId",
+			Type:    TransformWithN,
+		},
+		"project_ids": {
+			mapping: "ProjectId",
+			Type:    TransformWithN,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadKafkaInstances(req)
+	if err != nil {
+		return err
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "InstanceName",
+		idFiled:     "InstanceId",
+		targetField: "instances",
+		extra: map[string]SdkResponseMapping{
+			"AvailabilityZone": {
+				Field:         "availability_zone",
+				FieldRespFunc: kafkaAvailabilityZoneResp,
+			},
+			"Status": {
+				Field: "status",
+				FieldRespFunc: func(i interface{}) interface{} {
+					return fmt.Sprintf("%v", i)
+				},
+			},
+			"ProjectId": {
+				Field: "project_id",
+				FieldRespFunc: func(i interface{}) interface{} {
+					return fmt.Sprintf("%v", i)
+				},
+			},
+		},
+	})
+}
+
+func (s *KafkaService) CreateKafkaInstance(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKafkaInstanceCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	if cidrs, ok := d.GetOk("cidrs"); ok {
+		apiProcess.PutCalls(s.modifyKafkaSecurityRulesCall(d, "AddSecurityGroupRule", cidrs.(*schema.Set)))
+	}
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) createKafkaInstanceCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"availability_zone": {
+			mapping: "AvailabilityZone",
+			Type:    TransformWithN,
+		},
+		"cidrs": {
+			Ignore: true,
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	callback = kafkaApiCall("CreateInstance", params)
+	callback.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		id, err := getSdkValue("Data.InstanceId", *resp)
+		if err != nil {
+			return err
+		}
+		if id == nil {
+			return fmt.Errorf("no instance id returned by %s", call.action)
+		}
+		d.SetId(id.(string))
+		return s.checkKafkaInstanceState(d, d.Timeout(schema.TimeoutCreate))
+	}
+	return callback, err
+}
+
+func (s *KafkaService) ModifyKafkaInstance(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	if d.HasChange("instance_name") {
+		apiProcess.PutCalls(kafkaApiCall("ModifyInstanceName", map[string]interface{}{
+			"InstanceId":   d.Id(),
+			"InstanceName": d.Get("instance_name"),
+		}))
+	}
+
+	if d.HasChange("instance_type") || d.HasChange("disk_size") {
+		call := kafkaApiCall("ScaleInstance", map[string]interface{}{
+			"InstanceId":   d.Id(),
+			"InstanceType": d.Get("instance_type"),
+			"DiskSize":     d.Get("disk_size"),
+		})
+		call.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.checkKafkaInstanceState(d, d.Timeout(schema.TimeoutUpdate))
+		}
+		apiProcess.PutCalls(call)
+	}
+
+	if d.HasChange("cidrs") {
+		o, n := d.GetChange("cidrs")
+		del := o.(*schema.Set).Difference(n.(*schema.Set))
+		add := n.(*schema.Set).Difference(o.(*schema.Set))
+		if del.Len() > 0 {
+			apiProcess.PutCalls(s.modifyKafkaSecurityRulesCall(d, "DeleteSecurityGroupRules", del))
+		}
+		if add.Len() > 0 {
+			apiProcess.PutCalls(s.modifyKafkaSecurityRulesCall(d, "AddSecurityGroupRule", add))
+		}
+	}
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) modifyKafkaSecurityRulesCall(d *schema.ResourceData, action string, cidrs *schema.Set) (callback ApiCall) {
+	var list []string
+	for _, cidr := range cidrs.List() {
+		list = append(list, cidr.(string))
+	}
+	sort.Strings(list)
+	callback = kafkaApiCall(action, map[string]interface{}{
+		"Cidrs": strings.Join(list, ","),
+	})
+	// the instance id is unknown before the instance is created
+	executeCall := callback.executeCall
+	callback.executeCall = func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+		(*call.param)["InstanceId"] = d.Id()
+		return executeCall(d, client, call)
+	}
+	return callback
+}
+
+func (s *KafkaService) RemoveKafkaInstance(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	callback := kafkaApiCall("DeleteInstance", map[string]interface{}{
+		"InstanceId": d.Id(),
+	})
+	callback.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		return resource.Retry(15*time.Minute, func() *resource.RetryError {
+			_, callErr := s.ReadKafkaInstance(d, "")
+			if callErr != nil {
+				if notFoundError(callErr) {
+					return nil
+				} else {
+					return resource.NonRetryableError(fmt.Errorf("error on reading kafka instance when delete %q, %s", d.Id(), callErr))
+				}
+			}
+			_, callErr = call.executeCall(d, client, call)
+			if callErr == nil {
+				return nil
+			}
+			return resource.RetryableError(callErr)
+		})
+	}
+	apiProcess.PutCalls(callback)
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) checkKafkaInstanceState(d *schema.ResourceData, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     []string{"running"},
+		Refresh:    s.kafkaInstanceStateRefreshFunc(d, "error"),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *KafkaService) kafkaInstanceStateRefreshFunc(d *schema.ResourceData, failStates ...string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadKafkaInstance(d, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status := fmt.Sprintf("%v", data["Status"])
+		for _, v := range failStates {
+			if v == status {
+				return nil, "", fmt.Errorf("kafka instance status error, status:%v", status)
+			}
+		}
+		return data, status, nil
+	}
+}
+
+// start kafka topic
+
+func (s *KafkaService) ReadKafkaTopics(condition map[string]interface{}) (data []interface{}, err error) {
+	return s.readKafkaList("DescribeTopics", "Data.Topics", condition)
+}
+
+func (s *KafkaService) ReadKafkaTopic(d *schema.ResourceData) (data map[string]interface{}, err error) {
+	topicName := d.Get("topic_name").(string)
+	results, err := s.ReadKafkaTopics(map[string]interface{}{
+		"InstanceId": d.Get("instance_id"),
+		"TopicName":  topicName,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if item, ok := v.(map[string]interface{}); ok && item["TopicName"] == topicName {
+			return item, err
+		}
+	}
+	return data, fmt.Errorf("kafka topic %s not exist ", topicName)
+}
+
+func (s *KafkaService) ReadAndSetKafkaTopic(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadKafkaTopic(d)
+	if err != nil {
+		return err
+	}
+	extra := map[string]SdkResponseMapping{
+		"Configs": {
+			Field: "configs",
+			FieldRespFunc: func(i interface{}) interface{} {
+				return kafkaTopicConfigsResp(d, i)
+			},
+		},
+	}
+	SdkResponseAutoResourceData(d, r, data, extra)
+	return err
+}
+
+// kafkaTopicConfigsResp only returns the configs in the configuration, the other configs are the broker defaults.
+func kafkaTopicConfigsResp(d *schema.ResourceData, i interface{}) map[string]interface{} {
+	configs := make(map[string]interface{})
+	local := d.Get("configs").(map[string]interface{})
+	items, _ := i.([]interface{})
+	for _, item := range items {
+		if c, ok := item.(map[string]interface{}); ok {
+			name := fmt.Sprintf("%v", c["Name"])
+			if _, ok := local[name]; ok {
+				configs[name] = fmt.Sprintf("%v", c["Value"])
+			}
+		}
+	}
+	return configs
+}
+
+func (s *KafkaService) ReadAndSetKafkaTopics(d *schema.ResourceData, r *schema.Resource) (err error) {
+	req, err := mergeDataSourcesReq(d, r, nil)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadKafkaTopics(req)
+	if err != nil {
+		return err
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "TopicName",
+		idFiled:     "TopicName",
+		targetField: "topics",
+		extra: map[string]SdkResponseMapping{
+			"Configs": {
+				Field: "configs",
+				FieldRespFunc: func(i interface{}) interface{} {
+					configs := make(map[string]interface{})
+					items, _ := i.([]interface{})
+					for _, item := range items {
+						if c, ok := item.(map[string]interface{}); ok {
+							configs[fmt.Sprintf("%v", c["Name"])] = fmt.Sprintf("%v", c["Value"])
+						}
+					}
+					return configs
+				},
+			},
+		},
+	})
+}
+
+func kafkaTopicConfigsReq(d *schema.ResourceData, req map[string]interface{}) {
+	configs := d.Get("configs").(map[string]interface{})
+	var names []string
+	for k := range configs {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		req["Configs."+strconv.Itoa(i+1)+".Name"] = name
+		req["Configs."+strconv.Itoa(i+1)+".Value"] = configs[name]
+	}
+}
+
+func (s *KafkaService) CreateKafkaTopic(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	transform := map[string]SdkReqTransform{
+		"configs": {
+			Ignore: true,
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	kafkaTopicConfigsReq(d, params)
+	callback := kafkaApiCall("CreateTopic", params)
+	callback.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		d.SetId(AssembleIds(d.Get("instance_id").(string), d.Get("topic_name").(string)))
+		return err
+	}
+	apiProcess.PutCalls(callback)
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) ModifyKafkaTopic(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	transform := map[string]SdkReqTransform{
+		"partition_num": {},
+		"retention_ms":  {},
+	}
+	params, err := SdkRequestAutoMapping(d, r, true, transform, nil)
+	if err != nil {
+		return err
+	}
+	if d.HasChange("configs") {
+		kafkaTopicConfigsReq(d, params)
+	}
+	if len(params) == 0 {
+		return err
+	}
+	params["InstanceId"] = d.Get("instance_id")
+	params["TopicName"] = d.Get("topic_name")
+	apiProcess.PutCalls(kafkaApiCall("ModifyTopic", params))
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) RemoveKafkaTopic(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(kafkaApiCall("DeleteTopic", map[string]interface{}{
+		"InstanceId": d.Get("instance_id"),
+		"TopicName":  d.Get("topic_name"),
+	}))
+	return apiProcess.Run()
+}
+
+// start kafka consumer group
+
+func (s *KafkaService) ReadKafkaConsumerGroups(condition map[string]interface{}) (data []interface{}, err error) {
+	return s.readKafkaList("DescribeConsumerGroups", "Data.ConsumerGroups", condition)
+}
+
+func (s *KafkaService) ReadKafkaConsumerGroup(d *schema.ResourceData) (data map[string]interface{}, err error) {
+	groupName := d.Get("group_name").(string)
+	results, err := s.ReadKafkaConsumerGroups(map[string]interface{}{
+		"InstanceId": d.Get("instance_id"),
+		"GroupName":  groupName,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if item, ok := v.(map[string]interface{}); ok && item["GroupName"] == groupName {
+			return item, err
+		}
+	}
+	return data, fmt.Errorf("kafka consumer group %s not exist ", groupName)
+}
+
+func (s *KafkaService) ReadAndSetKafkaConsumerGroup(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadKafkaConsumerGroup(d)
+	if err != nil {
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *KafkaService) ReadAndSetKafkaConsumerGroups(d *schema.ResourceData, r *schema.Resource) (err error) {
+	req, err := mergeDataSourcesReq(d, r, nil)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadKafkaConsumerGroups(req)
+	if err != nil {
+		return err
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "GroupName",
+		idFiled:     "GroupName",
+		targetField: "consumer_groups",
+		extra:       map[string]SdkResponseMapping{},
+	})
+}
+
+func (s *KafkaService) CreateKafkaConsumerGroup(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	params, err := SdkRequestAutoMapping(d, r, false, nil, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	callback := kafkaApiCall("CreateConsumerGroup", params)
+	callback.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		d.SetId(AssembleIds(d.Get("instance_id").(string), d.Get("group_name").(string)))
+		return err
+	}
+	apiProcess.PutCalls(callback)
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) ModifyKafkaConsumerGroup(d *schema.ResourceData) (err error) {
+	if !d.HasChange("description") {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(kafkaApiCall("ModifyConsumerGroup", map[string]interface{}{
+		"InstanceId":  d.Get("instance_id"),
+		"GroupName":   d.Get("group_name"),
+		"Description": d.Get("description"),
+	}))
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) RemoveKafkaConsumerGroup(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(kafkaApiCall("DeleteConsumerGroup", map[string]interface{}{
+		"InstanceId": d.Get("instance_id"),
+		"GroupName":  d.Get("group_name"),
+	}))
+	return apiProcess.Run()
+}
+
+// start kafka acl
+
+// kafkaAclParams returns the parameters which identify the acl.
+func kafkaAclParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"InstanceId":     d.Get("instance_id"),
+		"ResourceType":   d.Get("resource_type"),
+		"ResourceName":   d.Get("resource_name"),
+		"UserName":       d.Get("user_name"),
+		"Host":           d.Get("host"),
+		"Operation":      d.Get("operation"),
+		"PermissionType": d.Get("permission_type"),
+	}
+}
+
+func (s *KafkaService) ReadKafkaAcl(d *schema.ResourceData) (data map[string]interface{}, err error) {
+	params := kafkaAclParams(d)
+	results, err := s.readKafkaList("DescribeAcls", "Data.Acls", map[string]interface{}{
+		"InstanceId":   params["InstanceId"],
+		"ResourceType": params["ResourceType"],
+		"ResourceName": params["ResourceName"],
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		match := true
+		for _, k := range []string{"UserName", "Host", "Operation", "PermissionType"} {
+			if item[k] != params[k] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return item, err
+		}
+	}
+	return data, fmt.Errorf("kafka acl %s not exist ", d.Id())
+}
+
+func (s *KafkaService) CreateKafkaAcl(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	callback := kafkaApiCall("CreateAcl", kafkaAclParams(d))
+	callback.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		d.SetId(AssembleIds(
+			d.Get("instance_id").(string),
+			d.Get("resource_type").(string),
+			d.Get("resource_name").(string),
+			d.Get("user_name").(string),
+			d.Get("host").(string),
+			d.Get("operation").(string),
+			d.Get("permission_type").(string),
+		))
+		return err
+	}
+	apiProcess.PutCalls(callback)
+	return apiProcess.Run()
+}
+
+func (s *KafkaService) RemoveKafkaAcl(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+	apiProcess.PutCalls(kafkaApiCall("DeleteAcl", kafkaAclParams(d)))
+	return apiProcess.Run()
+}
@@ -131,6 +131,12 @@ func formatKceInstancePara(nodeConfig map[string]interface{}) (para string) {
 			paraMap["MinCount"] = v
 			paraMap["MaxCount"] = v
 			break
+		case "LaunchTemplateVersion":
+			// the default version of the launch template is used if it is not set
+			if v.(int) != 0 {
+				paraMap[k] = v
+			}
+			break
 		case "KeyId":
 			keyIdList := v.(*schema.Set).List()
 			for keyIdx, keyId := range keyIdList {
@@ -300,6 +306,10 @@ func (s *KceService) checkClusterState(clusterId string, target []string, timeou
 
 func (s *KceService) CreateCluster(d *schema.ResourceData, r *schema.Resource) (err error) {
 	var createReq map[string]interface{}
+	err = s.fillKceNodesLaunchTemplate(d)
+	if err != nil {
+		return
+	}
 	createReq, err = formatKceClusterReq(d, r)
 
 	if err != nil {
@@ -421,6 +431,111 @@ func (s *KceService) CreateCluster(d *schema.ResourceData, r *schema.Resource) (
 	return
 }
 
+// kceNodeLaunchTemplateFields are the fields of node which can be taken from the launch template,
+// they are a part of the hash of node, so the values in the template are filled into the node config.
+var kceNodeLaunchTemplateFields = map[string]string{
+	"image_id":          "ImageId",
+	"subnet_id":         "SubnetId",
+	"security_group_id": "SecurityGroupId",
+	"charge_type":       "ChargeType",
+}
+
+func kceNodeFieldIsSet(v interface{}) bool {
+	if set, ok := v.(*schema.Set); ok {
+		return set.Len() > 0
+	}
+	return !isEmpty(v)
+}
+
+// fillKceNodeLaunchTemplate fills the fields of node which are not set with the launch template, so that
+// the hash of the node config is the same as the one calculated with the created instances.
+func (s *KceService) fillKceNodeLaunchTemplate(nodeConfig map[string]interface{}) (err error) {
+	templateId, _ := nodeConfig["launch_template_id"].(string)
+	version, _ := nodeConfig["launch_template_version"].(int)
+	if templateId == "" {
+		if version != 0 {
+			return fmt.Errorf("launch_template_version must be set with launch_template_id")
+		}
+		for field := range kceNodeLaunchTemplateFields {
+			if !kceNodeFieldIsSet(nodeConfig[field]) {
+				return fmt.Errorf("%s is required when launch_template_id is not set", field)
+			}
+		}
+		return err
+	}
+
+	kecService := KecService{s.client}
+	versionNumber := strconv.Itoa(version)
+	if version == 0 {
+		var template map[string]interface{}
+		template, err = kecService.ReadKecLaunchTemplate(nil, templateId)
+		if err != nil {
+			return err
+		}
+		versionNumber = fmt.Sprintf("%v", template["DefaultVersionNumber"])
+	}
+	versionData, err := kecService.ReadKecLaunchTemplateVersion(templateId, versionNumber)
+	if err != nil {
+		return err
+	}
+	templateData, _ := versionData["LaunchTemplateData"].(map[string]interface{})
+	for field, key := range kceNodeLaunchTemplateFields {
+		if kceNodeFieldIsSet(nodeConfig[field]) {
+			continue
+		}
+		value := templateData[key]
+		if field == "security_group_id" {
+			// the node only supports one security group, the same as formatKceInstancePara
+			var sgIds []interface{}
+			switch sg := value.(type) {
+			case []interface{}:
+				if len(sg) > 0 {
+					sgIds = append(sgIds, sg[0])
+				}
+			case string:
+				if sg != "" {
+					sgIds = append(sgIds, sg)
+				}
+			}
+			if len(sgIds) == 0 {
+				value = nil
+			} else {
+				value = schema.NewSet(schema.HashString, sgIds)
+			}
+		}
+		if !kceNodeFieldIsSet(value) {
+			return fmt.Errorf("%s is neither set nor found in the version %s of launch template %s", field, versionNumber, templateId)
+		}
+		nodeConfig[field] = value
+	}
+	return err
+}
+
+// fillKceNodesLaunchTemplate fills the node configs of the cluster with the launch templates before the cluster is created.
+func (s *KceService) fillKceNodesLaunchTemplate(d *schema.ResourceData) (err error) {
+	for _, block := range []string{"master_config", "worker_config"} {
+		nodeConfigs, ok := d.Get(block).([]interface{})
+		if !ok || len(nodeConfigs) == 0 {
+			continue
+		}
+		hashcodes := make(map[int]bool, len(nodeConfigs))
+		for idx, nodeConfig := range nodeConfigs {
+			if err = s.fillKceNodeLaunchTemplate(nodeConfig.(map[string]interface{})); err != nil {
+				return fmt.Errorf("%s.%d: %s", block, idx, err)
+			}
+			hashcode := kceInstanceNodeHashFunc()(nodeConfig)
+			if hashcodes[hashcode] {
+				return fmt.Errorf("%s.%d: the machine type is duplicated with the other block after the launch template is applied", block, idx)
+			}
+			hashcodes[hashcode] = true
+		}
+		if err = d.Set(block, nodeConfigs); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
 func (s *KceService) getAllNodeWithFilter(clusterId string, filter map[string]interface{}) ([]interface{}, error) {
 
 	var (
@@ -1021,7 +1136,8 @@ func convertInstanceToMapForSchema(insResp map[string]interface{}) (map[string]i
 
 	schemaMap := instanceConfig()
 
-	ignoreFields := []string{"advanced_setting"}
+	// the launch template is not returned with the instance, it is kept from the local config
+	ignoreFields := []string{"advanced_setting", "launch_template_id", "launch_template_version"}
 
 	// handle the top level fields
 	for k, v := range insResp {
@@ -0,0 +1,55 @@
+package ksyun
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestFillKceNodeLaunchTemplateWithoutTemplate(t *testing.T) {
+	s := KceService{}
+	node := map[string]interface{}{
+		"instance_type":     "N3.2B",
+		"image_id":          "img-1",
+		"subnet_id":         "subnet-1",
+		"security_group_id": schema.NewSet(schema.HashString, []interface{}{"sg-1"}),
+		"charge_type":       "Daily",
+	}
+	if err := s.fillKceNodeLaunchTemplate(node); err != nil {
+		t.Fatalf("the node with all the fields should be accepted: %s", err)
+	}
+	node["security_group_id"] = schema.NewSet(schema.HashString, nil)
+	if err := s.fillKceNodeLaunchTemplate(node); err == nil || !strings.Contains(err.Error(), "security_group_id") {
+		t.Fatalf("the node without security group should be rejected, got %v", err)
+	}
+	node["launch_template_version"] = 2
+	if err := s.fillKceNodeLaunchTemplate(node); err == nil || !strings.Contains(err.Error(), "launch_template_id") {
+		t.Fatalf("the version without template should be rejected, got %v", err)
+	}
+}
+
+func TestIsDuplicationMachineTypeWithLaunchTemplate(t *testing.T) {
+	machines := []interface{}{
+		map[string]interface{}{"instance_type": "N3.2B", "launch_template_id": "lt-1"},
+		map[string]interface{}{"instance_type": "N3.2B", "launch_template_id": "lt-2"},
+	}
+	if isDuplicationMachineType(machines) {
+		t.Fatal("the nodes from different launch templates should not be duplicated")
+	}
+	machines = append(machines, map[string]interface{}{"instance_type": "N3.2B", "launch_template_id": "lt-1"})
+	if !isDuplicationMachineType(machines) {
+		t.Fatal("the nodes from the same launch template should be duplicated")
+	}
+}
+
+func TestFormatKceInstanceParaLaunchTemplate(t *testing.T) {
+	para := formatKceInstancePara(map[string]interface{}{
+		"instance_type":           "N3.2B",
+		"launch_template_id":      "lt-1",
+		"launch_template_version": 0,
+	})
+	if !strings.Contains(para, `"LaunchTemplateId":"lt-1"`) || strings.Contains(para, "LaunchTemplateVersion") {
+		t.Fatalf("unexpected para %s", para)
+	}
+}
@@ -173,6 +173,10 @@ func (s *KceWorkerService) AddNewInstances(d *schema.ResourceData, r *schema.Res
 	// 整理 KecPara 参数
 	kecPara, _ := helper.GetSchemaListHeadMap(d, "worker_config")
 	kecPara["count"] = 1
+	kceService := KceService{s.client}
+	if err = kceService.fillKceNodeLaunchTemplate(kecPara); err != nil {
+		return fmt.Errorf("worker_config: %s", err)
+	}
 	handleKecParaWithPrefix(&params, []interface{}{kecPara}, "InstanceSet", 0, false, true)
 
 	// 整理 AdvanceSetting 参数
@@ -516,6 +520,8 @@ func (s *KceWorkerService) readAndSetAttachment(d *schema.ResourceData, resource
 			return fmt.Errorf("convert master instance failed: %s", err)
 		}
 		delete(instanceSaveMap, "vpc_id")
+		instanceSaveMap["launch_template_id"] = d.Get("worker_config.0.launch_template_id")
+		instanceSaveMap["launch_template_version"] = d.Get("worker_config.0.launch_template_version")
 		// masterSaveMap["count"] = 1
 		role, queryErr := queryRole(instanceId)
 		if queryErr != nil {
@@ -70,6 +70,21 @@ func (s *KcmService) ReadAndSetCertificate(d *schema.ResourceData, r *schema.Res
 		return err
 	}
 	SdkResponseAutoResourceData(d, r, data, nil)
+	return setCertificateInfo(d)
+}
+
+// setCertificateInfo sets the fields parsed from the public key, the certificate which can't be parsed is skipped.
+func setCertificateInfo(d *schema.ResourceData) (err error) {
+	info, parseErr := parseCertificatePem(d.Get("public_key").(string))
+	if parseErr != nil {
+		logger.Debug(logger.RespFormat, "ParseCertificate", d.Id(), parseErr)
+		return err
+	}
+	for field, value := range certificateInfoFields(info) {
+		if err = d.Set(field, value); err != nil {
+			return err
+		}
+	}
 	return err
 }
 
@@ -79,6 +94,8 @@ func (s *KcmService) ReadAndSetCertificates(d *schema.ResourceData, r *schema.Re
 			mapping: "CertificateId",
 			Type:    TransformWithN,
 		},
+		"domain":             {Ignore: true},
+		"expire_within_days": {Ignore: true},
 	}
 	req, err := mergeDataSourcesReq(d, r, transform)
 	if err != nil {
@@ -95,7 +112,38 @@ func (s *KcmService) ReadAndSetCertificates(d *schema.ResourceData, r *schema.Re
 		idFiled:     "CertificateId",
 		targetField: "certificates",
 		extra:       map[string]SdkResponseMapping{},
-	})
+	}, certificateInfoPlugin(time.Now()))
+}
+
+// certificateInfoPlugin parses the public key of the certificates and filters them by the domain and the days to expiry,
+// the certificate which can't be parsed is filtered out when any of the filters is set.
+func certificateInfoPlugin(now time.Time) matchPlugin {
+	return func(d *schema.ResourceData, item map[string]interface{}) (map[string]interface{}, bool, error) {
+		domain, filterDomain := d.GetOk("domain")
+		days, filterDays := d.GetOk("expire_within_days")
+		info, err := parseCertificatePem(fmt.Sprintf("%v", item["PublicKey"]))
+		if err != nil {
+			if filterDomain || filterDays {
+				return nil, true, nil
+			}
+			return item, true, nil
+		}
+		if filterDomain && !info.matchDomain(domain.(string)) {
+			return nil, true, nil
+		}
+		if filterDays && info.daysToExpiry(now) > days.(int) {
+			return nil, true, nil
+		}
+		item["NotAfter"] = info.NotAfter.Format(time.RFC3339)
+		item["SubjectCommonName"] = info.SubjectCommonName
+		var names []interface{}
+		for _, name := range info.SubjectAlternativeNames {
+			names = append(names, name)
+		}
+		item["SubjectAlternativeNames"] = names
+		item["DaysToExpiry"] = info.daysToExpiry(now)
+		return item, true, nil
+	}
 }
 
 func (s *KcmService) CreateCertificateCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
@@ -148,7 +196,13 @@ func (s *KcmService) CreateCertificate(d *schema.ResourceData, r *schema.Resourc
 }
 
 func (s *KcmService) ModifyCertificateCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
-	req, err := SdkRequestAutoMapping(d, r, true, nil, nil)
+	transform := map[string]SdkReqTransform{
+		"private_key": {Ignore: true},
+		"public_key":  {Ignore: true},
+	}
+	req, err := SdkRequestAutoMapping(d, r, true, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
 	if err != nil {
 		return callback, err
 	}
@@ -173,6 +227,10 @@ func (s *KcmService) ModifyCertificateCall(d *schema.ResourceData, r *schema.Res
 }
 
 func (s *KcmService) ModifyCertificate(d *schema.ResourceData, r *schema.Resource) (err error) {
+	if d.HasChange("public_key") || d.HasChange("private_key") {
+		// the new certificate is uploaded with the new name, so it doesn't need to be modified
+		return s.RotateCertificate(d, r)
+	}
 	call, err := s.ModifyCertificateCall(d, r)
 	if err != nil {
 		return err
@@ -180,6 +238,110 @@ func (s *KcmService) ModifyCertificate(d *schema.ResourceData, r *schema.Resourc
 	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
 }
 
+// ReadCertificateListeners returns the SLB listeners and the ALB listeners which use the certificate.
+func (s *KcmService) ReadCertificateListeners(certificateId string) (listenerIds []string, albListenerIds []string, err error) {
+	slbService := SlbService{s.client}
+	listeners, err := slbService.ReadListeners(map[string]interface{}{})
+	if err != nil {
+		return listenerIds, albListenerIds, err
+	}
+	for _, v := range listeners {
+		listener := v.(map[string]interface{})
+		if listener["CertificateId"] == certificateId {
+			listenerIds = append(listenerIds, listener["ListenerId"].(string))
+		}
+	}
+	albListenerService := AlbListenerService{s.client}
+	albListeners, err := albListenerService.readListeners(map[string]interface{}{})
+	if err != nil {
+		return listenerIds, albListenerIds, err
+	}
+	for _, v := range albListeners {
+		listener := v.(map[string]interface{})
+		if listener["CertificateId"] == certificateId {
+			albListenerIds = append(albListenerIds, listener["AlbListenerId"].(string))
+		}
+	}
+	return listenerIds, albListenerIds, err
+}
+
+func (s *KcmService) rebindListenerCall(action string, idField string, listenerId string, certificateId string) ApiCall {
+	params := map[string]interface{}{
+		idField:         listenerId,
+		"CertificateId": certificateId,
+	}
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.slbconn
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			if call.action == "ModifyAlbListener" {
+				resp, err = conn.ModifyAlbListener(call.param)
+			} else {
+				resp, err = conn.ModifyListeners(call.param)
+			}
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+// RotateCertificate uploads the new certificate, moves the listeners of the old certificate to the new one,
+// and deletes the old certificate at last, so the listeners are never left without a certificate.
+func (s *KcmService) RotateCertificate(d *schema.ResourceData, r *schema.Resource) (err error) {
+	oldId := d.Id()
+	createCall, err := s.CreateCertificateCall(d, r)
+	if err != nil {
+		return err
+	}
+	// the keys are not saved into the state until the rotation finishes, so it's retried by the next apply
+	d.Partial(true)
+	if err = ksyunApiCallNew([]ApiCall{createCall}, d, s.client, true); err != nil {
+		d.SetId(oldId)
+		return err
+	}
+	newId := d.Id()
+
+	err = s.rebindCertificateListeners(d, oldId, newId)
+	if err != nil {
+		// keep the old certificate in the state, and clean the new one which is not used
+		d.SetId(newId)
+		if removeErr := s.RemoveCertificate(d); removeErr != nil {
+			logger.Debug(logger.RespFormat, "DeleteCertificate", newId, removeErr)
+		}
+		d.SetId(oldId)
+		return fmt.Errorf("error on moving the listeners to the new certificate %q, %s", newId, err)
+	}
+
+	d.Partial(false)
+	d.SetId(oldId)
+	err = s.RemoveCertificate(d)
+	d.SetId(newId)
+	return err
+}
+
+func (s *KcmService) rebindCertificateListeners(d *schema.ResourceData, oldId string, newId string) (err error) {
+	listenerIds, albListenerIds, err := s.ReadCertificateListeners(oldId)
+	if err != nil {
+		return err
+	}
+	var calls []ApiCall
+	for _, listenerId := range listenerIds {
+		calls = append(calls, s.rebindListenerCall("ModifyListeners", "ListenerId", listenerId, newId))
+	}
+	for _, listenerId := range albListenerIds {
+		calls = append(calls, s.rebindListenerCall("ModifyAlbListener", "AlbListenerId", listenerId, newId))
+	}
+	if len(calls) == 0 {
+		return err
+	}
+	return ksyunApiCallNew(calls, d, s.client, true)
+}
+
 func (s *KcmService) RemoveCertificateCall(d *schema.ResourceData) (callback ApiCall, err error) {
 	removeReq := map[string]interface{}{
 		"CertificateId": d.Id(),
@@ -0,0 +1,125 @@
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *KecService) ReadKecInstanceTypeConfigs(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.kecconn
+	action := "DescribeInstanceTypeConfigs"
+	logger.Debug(logger.ReqFormat, action, condition)
+	if condition == nil {
+		resp, err = conn.DescribeInstanceTypeConfigs(nil)
+	} else {
+		resp, err = conn.DescribeInstanceTypeConfigs(&condition)
+	}
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("InstanceTypeConfigSet", *resp)
+	if err != nil {
+		return data, err
+	}
+	data = results.([]interface{})
+	return data, err
+}
+
+func (s *KecService) ReadAndSetKecInstanceTypes(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"instance_family": {
+			Type: TransformWithFilter,
+		},
+		"instance_type": {
+			Type: TransformWithFilter,
+		},
+		"availability_zone": {
+			Ignore: true,
+		},
+		"cpu": {
+			Ignore: true,
+		},
+		"memory": {
+			Ignore: true,
+		},
+		"gpu": {
+			Ignore: true,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadKecInstanceTypeConfigs(req)
+	if err != nil {
+		return err
+	}
+	for _, v := range data {
+		flattenKecInstanceTypeConfig(v.(map[string]interface{}))
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		idFiled:     "InstanceType",
+		targetField: "instance_types",
+		extra: map[string]SdkResponseMapping{
+			"CPU": {
+				Field: "cpu",
+			},
+			"GPU": {
+				Field: "gpu",
+			},
+			"AvailabilityZoneSet": {
+				Field: "availability_zones",
+				FieldRespFunc: func(i interface{}) interface{} {
+					var zones []interface{}
+					for _, zone := range i.([]interface{}) {
+						if m, ok := zone.(map[string]interface{}); ok {
+							zones = append(zones, m["AzCode"])
+						}
+					}
+					return zones
+				},
+			},
+		},
+	}, func(data *schema.ResourceData, m map[string]interface{}) (result map[string]interface{}, flag bool, err error) {
+		if zone, ok := d.GetOk("availability_zone"); ok {
+			flag = true
+			zones, _ := m["AvailabilityZoneSet"].([]interface{})
+			for _, v := range zones {
+				if z, ok := v.(map[string]interface{}); ok && z["AzCode"] == zone {
+					result = m
+				}
+			}
+		}
+		return result, flag, err
+	}, kecInstanceTypeNumberFilter(d, "cpu", "CPU"),
+		kecInstanceTypeNumberFilter(d, "memory", "Memory"),
+		kecInstanceTypeNumberFilter(d, "gpu", "GPU"))
+}
+
+// flattenKecInstanceTypeConfig moves the nested network limits to the top level of the instance type config.
+func flattenKecInstanceTypeConfig(config map[string]interface{}) {
+	if networkInterface, ok := config["NetworkInterface"].(map[string]interface{}); ok {
+		for k, v := range networkInterface {
+			config[k] = v
+		}
+		delete(config, "NetworkInterface")
+	}
+}
+
+func kecInstanceTypeNumberFilter(d *schema.ResourceData, field string, respField string) matchPlugin {
+	return func(data *schema.ResourceData, m map[string]interface{}) (result map[string]interface{}, flag bool, err error) {
+		if v, ok := d.GetOk(field); ok {
+			flag = true
+			if n, ok := m[respField].(float64); ok && int(n) == v.(int) {
+				result = m
+			}
+		}
+		return result, flag, err
+	}
+}
@@ -0,0 +1,498 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+// launchTemplateDataTransform returns the request transforms of the launch template data,
+// the parameters keep the same shape with RunInstances.
+func launchTemplateDataTransform() map[string]SdkReqTransform {
+	transform := map[string]SdkReqTransform{
+		"key_id": {
+			Type: TransformWithN,
+		},
+		"security_group_id": {
+			Type: TransformWithN,
+		},
+		"system_disk": {
+			Type: TransformListUnique,
+		},
+		"data_disks": {
+			mappings: map[string]string{
+				"data_disks": "DataDisk",
+				"disk_size":  "Size",
+				"disk_type":  "Type",
+			}, Type: TransformListN,
+		},
+	}
+	for k := range kecLaunchTemplateDataConfig(false) {
+		if _, ok := transform[k]; !ok {
+			transform[k] = SdkReqTransform{}
+		}
+	}
+	return transform
+}
+
+// launchTemplateDataResponseMapping converts the LaunchTemplateData to the schema of launch template data.
+func launchTemplateDataResponseMapping() map[string]SdkResponseMapping {
+	return map[string]SdkResponseMapping{
+		"SecurityGroupId": {
+			Field: "security_group_id",
+		},
+		"KeyId": {
+			Field: "key_id",
+		},
+		"DataDisk": {
+			Field: "data_disks",
+			FieldRespFunc: func(i interface{}) interface{} {
+				var result []interface{}
+				disks, ok := i.([]interface{})
+				if !ok {
+					return result
+				}
+				for _, disk := range disks {
+					diskMap, ok := disk.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					result = append(result, map[string]interface{}{
+						"DiskType":           diskMap["Type"],
+						"DiskSize":           diskMap["Size"],
+						"DiskSnapshotId":     diskMap["DiskSnapshotId"],
+						"DeleteWithInstance": diskMap["DeleteWithInstance"],
+					})
+				}
+				return result
+			},
+		},
+	}
+}
+
+func (s *KecService) ReadKecLaunchTemplates(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "MaxResults", "Marker", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.kecconn
+		action := "DescribeLaunchTemplates"
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("LaunchTemplateSet", *resp)
+		if err != nil {
+			return data, err
+		}
+		data = results.([]interface{})
+		return data, err
+	})
+}
+
+func (s *KecService) ReadKecLaunchTemplate(d *schema.ResourceData, templateId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if templateId == "" {
+		templateId = d.Id()
+	}
+	req := map[string]interface{}{
+		"LaunchTemplateId.1": templateId,
+	}
+	results, err = s.ReadKecLaunchTemplates(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Kec launch template %s not exist ", templateId)
+	}
+	return data, err
+}
+
+func (s *KecService) ReadKecLaunchTemplateVersions(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "MaxResults", "Marker", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.kecconn
+		action := "DescribeLaunchTemplateVersions"
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("LaunchTemplateVersionSet", *resp)
+		if err != nil {
+			return data, err
+		}
+		data = results.([]interface{})
+		return data, err
+	})
+}
+
+func (s *KecService) ReadKecLaunchTemplateVersion(templateId string, version string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	req := map[string]interface{}{
+		"LaunchTemplateId":        templateId,
+		"LaunchTemplateVersion.1": version,
+	}
+	results, err = s.ReadKecLaunchTemplateVersions(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Kec launch template %s version %s not exist ", templateId, version)
+	}
+	return data, err
+}
+
+func (s *KecService) ReadAndSetKecLaunchTemplate(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKecLaunchTemplate(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading launch template %q, %s", d.Id(), callErr))
+			}
+		}
+		SdkResponseAutoResourceData(d, r, data, nil)
+
+		defaultVersion := fmt.Sprintf("%v", data["DefaultVersionNumber"])
+		versionData, callErr := s.ReadKecLaunchTemplateVersion(d.Id(), defaultVersion)
+		if callErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on reading default version of launch template %q, %s", d.Id(), callErr))
+		}
+		_ = d.Set("version_description", versionData["VersionDescription"])
+		SdkResponseAutoResourceData(d, r, versionData["LaunchTemplateData"], launchTemplateDataResponseMapping())
+		return nil
+	})
+}
+
+func (s *KecService) ReadAndSetKecLaunchTemplateVersion(d *schema.ResourceData, r *schema.Resource) (err error) {
+	ids := DisassembleIds(d.Id())
+	if len(ids) < 2 {
+		return fmt.Errorf("the id of launch template version must be assembled by `launch_template_id:version_number`")
+	}
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKecLaunchTemplateVersion(ids[0], ids[1])
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading launch template version %q, %s", d.Id(), callErr))
+			}
+		}
+		extra := map[string]SdkResponseMapping{
+			"DefaultVersion": {
+				Field: "is_default_version",
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+		SdkResponseAutoResourceData(d, r, data["LaunchTemplateData"], launchTemplateDataResponseMapping())
+		return nil
+	})
+}
+
+func (s *KecService) CreateKecLaunchTemplate(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKecLaunchTemplateCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KecService) ModifyKecLaunchTemplate(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	nameCall, err := s.modifyKecLaunchTemplateNameCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(nameCall)
+
+	// changes of the template data are saved as a new version, which becomes the default version.
+	if d.HasChanges(kecLaunchTemplateDataKeys()...) || d.HasChange("version_description") {
+		versionCall, err := s.createKecLaunchTemplateVersionCall(d, r, d.Id())
+		if err != nil {
+			return err
+		}
+		apiProcess.PutCalls(versionCall)
+		apiProcess.PutCalls(s.modifyKecLaunchTemplateDefaultVersionCall(d.Id(), func(d *schema.ResourceData) string {
+			return strconv.Itoa(d.Get("latest_version_number").(int))
+		}))
+	}
+
+	return apiProcess.Run()
+}
+
+func (s *KecService) RemoveKecLaunchTemplate(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeKecLaunchTemplateCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KecService) CreateKecLaunchTemplateVersion(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	templateId := d.Get("launch_template_id").(string)
+	createCall, err := s.createKecLaunchTemplateVersionCall(d, r, templateId)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+	if d.Get("set_as_default").(bool) {
+		apiProcess.PutCalls(s.modifyKecLaunchTemplateDefaultVersionCall(templateId, func(d *schema.ResourceData) string {
+			return strconv.Itoa(d.Get("version_number").(int))
+		}))
+	}
+
+	return apiProcess.Run()
+}
+
+func (s *KecService) ModifyKecLaunchTemplateVersion(d *schema.ResourceData) (err error) {
+	if !d.HasChange("set_as_default") || !d.Get("set_as_default").(bool) {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	ids := DisassembleIds(d.Id())
+	apiProcess.PutCalls(s.modifyKecLaunchTemplateDefaultVersionCall(ids[0], func(d *schema.ResourceData) string {
+		return ids[1]
+	}))
+
+	return apiProcess.Run()
+}
+
+func (s *KecService) RemoveKecLaunchTemplateVersion(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeKecLaunchTemplateVersionCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KecService) createKecLaunchTemplateCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	params, err := SdkRequestAutoMapping(d, r, false, launchTemplateDataTransform(), nil)
+	if err != nil {
+		return callback, err
+	}
+	params["LaunchTemplateName"] = d.Get("launch_template_name")
+	if v, ok := d.GetOk("version_description"); ok {
+		params["VersionDescription"] = v
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateLaunchTemplate",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("LaunchTemplateId", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *KecService) modifyKecLaunchTemplateNameCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("launch_template_name") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"LaunchTemplateId":   d.Id(),
+		"LaunchTemplateName": d.Get("launch_template_name"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyLaunchTemplate",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+// createKecLaunchTemplateVersionCall creates a new version with the whole template data,
+// the version number is saved into `version_number` or `latest_version_number`.
+func (s *KecService) createKecLaunchTemplateVersionCall(d *schema.ResourceData, r *schema.Resource, templateId string) (callback ApiCall, err error) {
+	params, err := SdkRequestAutoMapping(d, r, false, launchTemplateDataTransform(), nil)
+	if err != nil {
+		return callback, err
+	}
+	params["LaunchTemplateId"] = templateId
+	if v, ok := d.GetOk("version_description"); ok {
+		params["VersionDescription"] = v
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateLaunchTemplateVersion",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			version, err := getSdkValue("VersionNumber", *resp)
+			if err != nil {
+				return err
+			}
+			versionNumber, err := strconv.Atoi(fmt.Sprintf("%v", version))
+			if err != nil {
+				return err
+			}
+			if d.Id() == templateId {
+				return d.Set("latest_version_number", versionNumber)
+			}
+			d.SetId(AssembleIds(templateId, strconv.Itoa(versionNumber)))
+			return d.Set("version_number", versionNumber)
+		},
+	}
+	return callback, err
+}
+
+func (s *KecService) modifyKecLaunchTemplateDefaultVersionCall(templateId string, version func(d *schema.ResourceData) string) (callback ApiCall) {
+	params := map[string]interface{}{
+		"LaunchTemplateId": templateId,
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyLaunchTemplateDefaultVersion",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			// the version is known after the version has been created
+			(*call.param)["LaunchTemplateVersion"] = version(d)
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback
+}
+
+func (s *KecService) removeKecLaunchTemplateCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"LaunchTemplateId": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteLaunchTemplate",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(5*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadKecLaunchTemplate(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading launch template when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return retryError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *KecService) removeKecLaunchTemplateVersionCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	ids := DisassembleIds(d.Id())
+	removeReq := map[string]interface{}{
+		"LaunchTemplateId":        ids[0],
+		"LaunchTemplateVersion.1": ids[1],
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteLaunchTemplateVersions",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			if notFoundError(baseErr) {
+				return nil
+			}
+			return baseErr
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
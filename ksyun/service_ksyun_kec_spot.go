@@ -0,0 +1,123 @@
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+const (
+	kecSpotAsPriceGo      = "SpotAsPriceGo"
+	kecSpotWithPriceLimit = "SpotWithPriceLimit"
+)
+
+// kecSpotConfig returns the spot parameters shared by instance, scaling configuration and launch template.
+func kecSpotConfig(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"spot_strategy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: forceNew,
+			ValidateFunc: validation.StringInSlice([]string{
+				kecSpotAsPriceGo,
+				kecSpotWithPriceLimit,
+			}, false),
+			Description: "The spot strategy of the instance. Valid values: `SpotAsPriceGo`, bid with the current market price; `SpotWithPriceLimit`, bid with `spot_price_limit`. The instance is charged as an on-demand instance if not set.",
+		},
+		"spot_price_limit": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "The max hourly price of the spot instance. It is required when `spot_strategy` is `SpotWithPriceLimit`.",
+		},
+		"spot_interruption_behavior": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: forceNew,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Terminate",
+				"Stop",
+			}, false),
+			Description: "The behavior when the spot instance is interrupted. Valid values: `Terminate`, `Stop`.",
+		},
+	}
+}
+
+// checkKecSpotParams checks the spot parameters which can not be validated by the schema.
+func checkKecSpotParams(d *schema.ResourceData) error {
+	strategy := d.Get("spot_strategy").(string)
+	_, hasLimit := d.GetOk("spot_price_limit")
+	_, hasBehavior := d.GetOk("spot_interruption_behavior")
+	switch {
+	case strategy == kecSpotWithPriceLimit && !hasLimit:
+		return fmt.Errorf("spot_price_limit is required when spot_strategy is %s", kecSpotWithPriceLimit)
+	case strategy != kecSpotWithPriceLimit && hasLimit:
+		return fmt.Errorf("spot_price_limit only works when spot_strategy is %s", kecSpotWithPriceLimit)
+	case strategy == "" && hasBehavior:
+		return fmt.Errorf("spot_interruption_behavior only works with spot_strategy")
+	}
+	return nil
+}
+
+func (s *KecService) ReadKecSpotPrices(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "MaxResults", "Marker", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.kecconn
+		action := "DescribeSpotPrice"
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("SpotPriceSet", *resp)
+		if err != nil {
+			return data, err
+		}
+		data = results.([]interface{})
+		return data, err
+	})
+}
+
+func (s *KecService) ReadAndSetKecSpotPrices(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"instance_types": {
+			mapping: "InstanceType",
+			Type:    TransformWithN,
+		},
+		"availability_zones": {
+			mapping: "AvailabilityZone",
+			Type:    TransformWithN,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadKecSpotPrices(req)
+	if err != nil {
+		return err
+	}
+
+	var result []map[string]interface{}
+	for _, v := range data {
+		result = append(result, v.(map[string]interface{}))
+	}
+	// the prices of an instance type are returned once per availability zone.
+	_, _, err = SdkSliceMapping(d, result, SdkSliceData{
+		IdField: "InstanceType",
+		IdMappingFunc: func(idField string, item map[string]interface{}) string {
+			return fmt.Sprintf("%v:%v", item["AvailabilityZone"], item[idField])
+		},
+		SliceMappingFunc: func(item map[string]interface{}) map[string]interface{} {
+			return SdkResponseAutoMapping(r, "spot_prices", item, nil, nil)
+		},
+		TargetName: "spot_prices",
+	})
+	return err
+}
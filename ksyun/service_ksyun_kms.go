@@ -0,0 +1,239 @@
+package ksyun
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+const kmsKeyStatePendingDeletion = "PendingDeletion"
+
+type KmsService struct {
+	client *KsyunClient
+}
+
+// kmsApiCall returns an ApiCall which calls the kms open api, the sdk doesn't provide the kms client.
+func kmsApiCall(action string, params map[string]interface{}) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(client.kmsconn, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+func (s *KmsService) ReadKmsKey(keyId string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"KeyId": keyId,
+	}
+	action := "DescribeKey"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(s.client.kmsconn, action, &req)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("KeyMetadata", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = results.(map[string]interface{})
+	// the key pending deletion can't be used anymore, so it's treated as deleted
+	if len(data) == 0 || data["KeyState"] == kmsKeyStatePendingDeletion {
+		return data, fmt.Errorf("kms key %s not exist ", keyId)
+	}
+	return data, err
+}
+
+func (s *KmsService) ReadKmsKeyRotation(keyId string) (enabled bool, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"KeyId": keyId,
+	}
+	action := "GetKeyRotationStatus"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(s.client.kmsconn, action, &req)
+	if err != nil {
+		return enabled, err
+	}
+	results, err = getSdkValue("KeyRotationEnabled", *resp)
+	if err != nil {
+		return enabled, err
+	}
+	return fmt.Sprintf("%v", results) == "true", err
+}
+
+func (s *KmsService) ReadAndSetKmsKey(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadKmsKey(d.Id())
+	if err != nil {
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	if err = d.Set("is_enabled", data["KeyState"] == "Enabled"); err != nil {
+		return err
+	}
+	rotation, err := s.ReadKmsKeyRotation(d.Id())
+	if err != nil {
+		return err
+	}
+	return d.Set("enable_key_rotation", rotation)
+}
+
+func (s *KmsService) CreateKmsKey(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"is_enabled":              {Ignore: true},
+		"enable_key_rotation":     {Ignore: true},
+		"deletion_window_in_days": {Ignore: true},
+	}
+	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	createCall := kmsApiCall("CreateKey", req)
+	createCall.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		id, err := getSdkValue("KeyMetadata.KeyId", *resp)
+		if err != nil {
+			return err
+		}
+		d.SetId(id.(string))
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+	apiProcess.PutCalls(s.kmsKeyStateCalls(d)...)
+	return apiProcess.Run()
+}
+
+// kmsKeyStateCalls returns the calls which enable or disable the key and its rotation,
+// the key is created as enabled without rotation.
+func (s *KmsService) kmsKeyStateCalls(d *schema.ResourceData) (calls []ApiCall) {
+	keyCall := func(action string) ApiCall {
+		call := kmsApiCall(action, map[string]interface{}{})
+		executeCall := call.executeCall
+		call.executeCall = func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			(*call.param)["KeyId"] = d.Id()
+			return executeCall(d, client, call)
+		}
+		return call
+	}
+	isEnabled := d.Get("is_enabled").(bool)
+	if d.IsNewResource() && !isEnabled || !d.IsNewResource() && d.HasChange("is_enabled") {
+		if isEnabled {
+			calls = append(calls, keyCall("EnableKey"))
+		} else {
+			calls = append(calls, keyCall("DisableKey"))
+		}
+	}
+	rotation := d.Get("enable_key_rotation").(bool)
+	if d.IsNewResource() && rotation || !d.IsNewResource() && d.HasChange("enable_key_rotation") {
+		if rotation {
+			calls = append(calls, keyCall("EnableKeyRotation"))
+		} else {
+			calls = append(calls, keyCall("DisableKeyRotation"))
+		}
+	}
+	return calls
+}
+
+func (s *KmsService) ModifyKmsKey(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	if d.HasChange("alias") {
+		apiProcess.PutCalls(kmsApiCall("UpdateAlias", map[string]interface{}{
+			"KeyId":    d.Id(),
+			"NewAlias": d.Get("alias"),
+		}))
+	}
+	if d.HasChange("description") {
+		apiProcess.PutCalls(kmsApiCall("UpdateKeyDescription", map[string]interface{}{
+			"KeyId":       d.Id(),
+			"Description": d.Get("description"),
+		}))
+	}
+	apiProcess.PutCalls(s.kmsKeyStateCalls(d)...)
+	return apiProcess.Run()
+}
+
+// RemoveKmsKey schedules the deletion of the key, the key is deleted after the deletion window.
+func (s *KmsService) RemoveKmsKey(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	call := kmsApiCall("ScheduleKeyDeletion", map[string]interface{}{
+		"KeyId":               d.Id(),
+		"PendingWindowInDays": d.Get("deletion_window_in_days"),
+	})
+	call.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		if notFoundError(baseErr) {
+			return nil
+		}
+		return baseErr
+	}
+	apiProcess.PutCalls(call)
+	return apiProcess.Run()
+}
+
+// KmsEncrypt encrypts the plaintext, the api requires the plaintext in base64.
+func (s *KmsService) KmsEncrypt(keyId string, plaintext string) (ciphertext string, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"KeyId":     keyId,
+		"Plaintext": base64.StdEncoding.EncodeToString([]byte(plaintext)),
+	}
+	action := "Encrypt"
+	logger.Debug(logger.ReqFormat, action, map[string]interface{}{"KeyId": keyId})
+	resp, err = ksyunOpenApiCall(s.client.kmsconn, action, &req)
+	if err != nil {
+		return ciphertext, err
+	}
+	results, err = getSdkValue("CiphertextBlob", *resp)
+	if err != nil {
+		return ciphertext, err
+	}
+	return fmt.Sprintf("%v", results), err
+}
+
+// KmsDecrypt decrypts the ciphertext blob, the api returns the plaintext in base64.
+func (s *KmsService) KmsDecrypt(ciphertext string) (plaintext string, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"CiphertextBlob": ciphertext,
+	}
+	action := "Decrypt"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(s.client.kmsconn, action, &req)
+	if err != nil {
+		return plaintext, err
+	}
+	results, err = getSdkValue("Plaintext", *resp)
+	if err != nil {
+		return plaintext, err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(fmt.Sprintf("%v", results))
+	if err != nil {
+		return plaintext, fmt.Errorf("the plaintext is not in base64, %s", err)
+	}
+	return string(decoded), err
+}
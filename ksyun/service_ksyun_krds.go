@@ -814,6 +814,7 @@ func createKrdsDbInstance(d *schema.ResourceData, meta interface{}) (call ksyunA
 		"instance_has_eip":      {Ignore: true},
 		"parameters":            {Ignore: true},
 		"force_restart":         {Ignore: true},
+		"restore_from":          {Ignore: true},
 		"availability_zone_1":   {mapping: "AvailabilityZone.1"},
 		"availability_zone_2":   {mapping: "AvailabilityZone.2"},
 	}
@@ -824,16 +825,24 @@ func createKrdsDbInstance(d *schema.ResourceData, meta interface{}) (call ksyunA
 	if err != nil {
 		return call, err
 	}
+	action, err := krdsRestoreParams(d, createReq)
+	if err != nil {
+		return call, err
+	}
 	call = func(d *schema.ResourceData, meta interface{}) (err error) {
 		conn := meta.(*KsyunClient).krdsconn
-		action := "CreateDBInstance"
 
 		// 如果创建了临时参数组，创建实例的时候使用该参数组
 		if d.Get("db_parameter_group_id") != nil && d.Get("db_parameter_group_id").(string) != "" {
 			createReq["DBParameterGroupId"] = d.Get("db_parameter_group_id")
 		}
 		logger.Debug(logger.RespFormat, action, createReq)
-		resp, err := conn.CreateDBInstance(&createReq)
+		var resp *map[string]interface{}
+		if action == "CreateDBInstance" {
+			resp, err = conn.CreateDBInstance(&createReq)
+		} else {
+			resp, err = ksyunOpenApiCall(conn.Client, action, &createReq)
+		}
 		if err != nil {
 
 			// 由于临时参数组不被tf管理，创建实例失败，需要手动回收
@@ -1349,33 +1358,45 @@ func krdsInstanceStateRefreshFunc(d *schema.ResourceData, meta interface{}, inst
 	}
 }
 
-func krdsInstanceCustomizeDiff() schema.CustomizeDiffFunc {
+// krdsInstanceCustomizeDiff runs the plan time checks of the KRDS resources, each resource passes the checks
+// that fit its own schema.
+func krdsInstanceCustomizeDiff(checks ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
-		if diff.HasChange("parameters") {
-			var (
-				data map[string]interface{}
-			)
-			_, n := diff.GetChange("parameters")
-			data, err = readKrdsDefaultParameters(nil, diff, i)
-			if err != nil {
+		for _, check := range checks {
+			if err = check(diff, i); err != nil {
 				return err
 			}
-			for _, v := range n.(*schema.Set).List() {
-				key := v.(map[string]interface{})["name"]
-				exist := false
-				for k := range data {
-					if k == key.(string) {
-						exist = true
-						break
-					}
-				}
-				if !exist {
-					return fmt.Errorf("parameter %s is not support", key)
+		}
+		return err
+	}
+}
+
+// checkKrdsParametersDiff validates that the parameters are supported by the engine of the instance.
+func checkKrdsParametersDiff(diff *schema.ResourceDiff, i interface{}) (err error) {
+	if diff.HasChange("parameters") {
+		var (
+			data map[string]interface{}
+		)
+		_, n := diff.GetChange("parameters")
+		data, err = readKrdsDefaultParameters(nil, diff, i)
+		if err != nil {
+			return err
+		}
+		for _, v := range n.(*schema.Set).List() {
+			key := v.(map[string]interface{})["name"]
+			exist := false
+			for k := range data {
+				if k == key.(string) {
+					exist = true
+					break
 				}
 			}
+			if !exist {
+				return fmt.Errorf("parameter %s is not support", key)
+			}
 		}
-		return err
 	}
+	return err
 }
 
 func createKrdsSecurityGroupRule(d *schema.ResourceData, meta interface{}) (err error) {
@@ -0,0 +1,315 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *KrdsService) ReadKrdsAccounts(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.krdsconn
+	action := "DescribeInstanceAccounts"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("Data.InstanceAccounts", *resp)
+	if err != nil {
+		return data, err
+	}
+	return If2Slice(results)
+}
+
+func (s *KrdsService) ReadKrdsAccount(d *schema.ResourceData, instanceId string, name string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if instanceId == "" {
+		instanceId = d.Get("db_instance_identifier").(string)
+	}
+	if name == "" {
+		name = d.Get("account_name").(string)
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"InstanceAccountName":  name,
+	}
+	results, err = s.ReadKrdsAccounts(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if m, ok := v.(map[string]interface{}); ok && m["InstanceAccountName"] == name {
+			data = m
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Krds account %s:%s not exist ", instanceId, name)
+	}
+	return data, err
+}
+
+func (s *KrdsService) ReadAndSetKrdsAccount(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKrdsAccount(d, "", "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading krds account %q, %s", d.Id(), callErr))
+			}
+		}
+		extra := map[string]SdkResponseMapping{
+			"InstanceAccountName": {
+				Field: "account_name",
+			},
+			"InstanceAccountDescription": {
+				Field: "description",
+			},
+			"InstanceAccountStatus": {
+				Field: "status",
+			},
+			"InstanceAccountPrivileges": {
+				Field: "privileges",
+				FieldRespFunc: func(i interface{}) interface{} {
+					var privileges []interface{}
+					if items, ok := i.([]interface{}); ok {
+						for _, item := range items {
+							if m, ok := item.(map[string]interface{}); ok {
+								privileges = append(privileges, map[string]interface{}{
+									"database_name": m["InstanceDatabaseName"],
+									"privilege":     m["Privilege"],
+								})
+							}
+						}
+					}
+					return privileges
+				},
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+		return nil
+	})
+}
+
+func (s *KrdsService) CreateKrdsAccount(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKrdsAccountCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) ModifyKrdsAccount(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	descriptionCall, err := s.modifyKrdsAccountDescriptionCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(descriptionCall)
+
+	passwordCall, err := s.modifyKrdsAccountPasswordCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(passwordCall)
+
+	privilegesCall, err := s.modifyKrdsAccountPrivilegesCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(privilegesCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) RemoveKrdsAccount(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeKrdsAccountCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+// krdsAccountPrivilegesParams converts the privileges to InstanceAccountPrivileges.N parameters.
+func krdsAccountPrivilegesParams(d *schema.ResourceData, params map[string]interface{}) {
+	for i, v := range d.Get("privileges").(*schema.Set).List() {
+		privilege := v.(map[string]interface{})
+		prefix := "InstanceAccountPrivileges." + strconv.Itoa(i+1)
+		params[prefix+".InstanceDatabaseName"] = privilege["database_name"]
+		params[prefix+".Privilege"] = privilege["privilege"]
+	}
+}
+
+func (s *KrdsService) createKrdsAccountCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {
+			mapping: "DBInstanceIdentifier",
+		},
+		"account_name": {
+			mapping: "InstanceAccountName",
+		},
+		"account_password": {
+			mapping: "InstanceAccountPassword",
+		},
+		"description": {
+			mapping: "InstanceAccountDescription",
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+	krdsAccountPrivilegesParams(d, params)
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateInstanceAccount",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			instanceId := d.Get("db_instance_identifier").(string)
+			err = checkKrdsInstanceState(d, client, instanceId, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.krdsconn
+			logger.Debug(logger.ReqFormat, call.action, krdsAccountLogParams(*(call.param)))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, krdsAccountLogParams(*(call.param)), *resp)
+			d.SetId(strings.Join([]string{d.Get("db_instance_identifier").(string), d.Get("account_name").(string)}, ":"))
+			return checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) modifyKrdsAccountDescriptionCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("description") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DBInstanceIdentifier":       d.Get("db_instance_identifier"),
+		"InstanceAccountName":        d.Get("account_name"),
+		"InstanceAccountDescription": d.Get("description"),
+	}
+	return s.krdsAccountCall(params, "ModifyInstanceAccountInfo"), err
+}
+
+func (s *KrdsService) modifyKrdsAccountPasswordCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("account_password") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DBInstanceIdentifier":    d.Get("db_instance_identifier"),
+		"InstanceAccountName":     d.Get("account_name"),
+		"InstanceAccountPassword": d.Get("account_password"),
+	}
+	return s.krdsAccountCall(params, "ResetPasswordForInstanceAccount"), err
+}
+
+func (s *KrdsService) modifyKrdsAccountPrivilegesCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("privileges") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"InstanceAccountName":  d.Get("account_name"),
+	}
+	krdsAccountPrivilegesParams(d, params)
+	return s.krdsAccountCall(params, "ModifyInstanceAccountPrivileges"), err
+}
+
+// krdsAccountLogParams hides the password of the account in the log.
+func krdsAccountLogParams(params map[string]interface{}) map[string]interface{} {
+	if _, ok := params["InstanceAccountPassword"]; !ok {
+		return params
+	}
+	result := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		result[k] = v
+	}
+	result["InstanceAccountPassword"] = "******"
+	return result
+}
+
+// krdsAccountCall returns a call of the account action which needs the krds instance is active.
+func (s *KrdsService) krdsAccountCall(params map[string]interface{}, action string) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.ReqFormat, call.action, krdsAccountLogParams(*(call.param)))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, krdsAccountLogParams(*(call.param)), *resp)
+			return checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutUpdate))
+		},
+	}
+}
+
+func (s *KrdsService) removeKrdsAccountCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"InstanceAccountName":  d.Get("account_name"),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteInstanceAccount",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.ReqFormat, call.action, krdsAccountLogParams(*(call.param)))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadKrdsAccount(d, "", "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading krds account when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, krdsAccountLogParams(*(call.param)), *resp)
+			return checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutDelete))
+		},
+	}
+	return callback, err
+}
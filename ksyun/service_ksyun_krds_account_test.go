@@ -0,0 +1,17 @@
+package ksyun
+
+import "testing"
+
+func TestKrdsAccountLogParams(t *testing.T) {
+	params := map[string]interface{}{
+		"InstanceAccountName":     "tf_user",
+		"InstanceAccountPassword": "Secret@123",
+	}
+	logParams := krdsAccountLogParams(params)
+	if logParams["InstanceAccountPassword"] != "******" || logParams["InstanceAccountName"] != "tf_user" {
+		t.Fatalf("unexpected log params %v", logParams)
+	}
+	if params["InstanceAccountPassword"] != "Secret@123" {
+		t.Fatal("the request params should not be changed")
+	}
+}
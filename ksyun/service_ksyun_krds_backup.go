@@ -0,0 +1,297 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *KrdsService) ReadKrdsBackups(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "MaxRecords", "Marker", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.krdsconn
+		action := "DescribeDBBackups"
+		logger.Debug(logger.ReqFormat, action, condition)
+		if condition == nil {
+			resp, err = conn.DescribeDBBackups(nil)
+		} else {
+			resp, err = conn.DescribeDBBackups(&condition)
+		}
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("Data.DBBackup", *resp)
+		if err != nil {
+			return data, err
+		}
+		return If2Slice(results)
+	})
+}
+
+func (s *KrdsService) ReadKrdsBackup(d *schema.ResourceData, backupId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if backupId == "" {
+		backupId = d.Id()
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"DBBackupIdentifier":   backupId,
+	}
+	results, err = s.ReadKrdsBackups(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if m, ok := v.(map[string]interface{}); ok && m["DBBackupIdentifier"] == backupId {
+			data = m
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Krds backup %s not exist ", backupId)
+	}
+	return data, err
+}
+
+func (s *KrdsService) ReadAndSetKrdsBackup(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKrdsBackup(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading krds backup %q, %s", d.Id(), callErr))
+			}
+		}
+		SdkResponseAutoResourceData(d, r, data, krdsBackupResponseMapping())
+		return nil
+	})
+}
+
+func (s *KrdsService) ReadAndSetKrdsBackups(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {
+			mapping: "DBInstanceIdentifier",
+		},
+		"backup_type": {
+			mapping: "BackupType",
+		},
+		"ids": {
+			Ignore: true,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadKrdsBackups(req)
+	if err != nil {
+		return err
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		idFiled:     "DBBackupIdentifier",
+		nameField:   "DBBackupName",
+		targetField: "backups",
+		extra:       krdsBackupResponseMapping(),
+	}, func(data *schema.ResourceData, m map[string]interface{}) (result map[string]interface{}, flag bool, err error) {
+		if ids, ok := d.GetOk("ids"); ok {
+			flag = true
+			for _, id := range ids.(*schema.Set).List() {
+				if m["DBBackupIdentifier"] == id {
+					result = m
+				}
+			}
+		}
+		return result, flag, err
+	})
+}
+
+func krdsBackupResponseMapping() map[string]SdkResponseMapping {
+	return map[string]SdkResponseMapping{
+		"DBBackupIdentifier": {
+			Field: "backup_id",
+		},
+		"DBBackupName": {
+			Field: "backup_name",
+		},
+		"DBInstanceIdentifier": {
+			Field: "db_instance_identifier",
+		},
+	}
+}
+
+func (s *KrdsService) CreateKrdsBackup(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKrdsBackupCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) RemoveKrdsBackup(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeKrdsBackupCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) createKrdsBackupCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {
+			mapping: "DBInstanceIdentifier",
+		},
+		"backup_name": {
+			mapping: "DBBackupName",
+		},
+		"description": {
+			mapping: "Description",
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateDBBackup",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("Data.DBBackup.DBBackupIdentifier", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			return s.checkKrdsBackupState(d, []string{"COMPLETED"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) removeKrdsBackupCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DBBackupIdentifier": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteDBBackup",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadKrdsBackup(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading krds backup when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) checkKrdsBackupState(d *schema.ResourceData, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     target,
+		Refresh:    s.krdsBackupStateRefreshFunc(d, []string{"FAILED"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *KrdsService) krdsBackupStateRefreshFunc(d *schema.ResourceData, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadKrdsBackup(d, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status, err := getSdkValue("BackupStatus", data)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, v := range failStates {
+			if v == status.(string) {
+				return nil, "", fmt.Errorf("krds backup status error, status:%v", status)
+			}
+		}
+		return data, status.(string), nil
+	}
+}
+
+// krdsRestoreParams adds the restore parameters to the creating request of the krds instance,
+// and returns the action which restores the instance from a backup or to a point in time.
+func krdsRestoreParams(d *schema.ResourceData, req map[string]interface{}) (action string, err error) {
+	restoreFrom, ok := d.GetOk("restore_from")
+	if !ok {
+		return "CreateDBInstance", err
+	}
+	restore := restoreFrom.([]interface{})[0].(map[string]interface{})
+	backupId, _ := restore["backup_id"].(string)
+	restoreTime, _ := restore["restore_time"].(string)
+	if (backupId == "") == (restoreTime == "") {
+		return action, fmt.Errorf("exactly one of restore_from.0.backup_id and restore_from.0.restore_time must be set")
+	}
+	req["DBInstanceIdentifier"] = restore["source_db_instance_identifier"]
+	if backupId != "" {
+		req["DBBackupIdentifier"] = backupId
+		return "RestoreDBInstanceFromDBBackup", err
+	}
+	t, err := time.Parse(time.RFC3339, restoreTime)
+	if err != nil {
+		return action, err
+	}
+	req["RestorableTime"] = t.Format("2006-01-02 15:04:05")
+	return "RestoreDBInstanceToPointInTime", err
+}
@@ -0,0 +1,175 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *KrdsService) ReadKrdsBackupPolicy(d *schema.ResourceData, instanceId string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+		ok      bool
+	)
+	if instanceId == "" {
+		instanceId = d.Id()
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+	}
+	conn := s.client.krdsconn
+	action := "DescribeDBBackupPolicy"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.DescribeDBBackupPolicy(&req)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("Data.DBBackupPolicy", *resp)
+	if err != nil {
+		return data, err
+	}
+	if data, ok = results.(map[string]interface{}); !ok || len(data) == 0 {
+		return data, fmt.Errorf("Krds backup policy %s not exist ", instanceId)
+	}
+	return data, err
+}
+
+func (s *KrdsService) ReadAndSetKrdsBackupPolicy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKrdsBackupPolicy(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading krds backup policy %q, %s", d.Id(), callErr))
+			}
+		}
+		data["DBInstanceIdentifier"] = d.Id()
+		extra := map[string]SdkResponseMapping{
+			"DBInstanceIdentifier": {
+				Field: "db_instance_identifier",
+			},
+			"PreferredBackupPeriod": {
+				Field: "preferred_backup_weekdays",
+				FieldRespFunc: func(i interface{}) interface{} {
+					var weekdays []interface{}
+					if s, ok := i.(string); ok && s != "" {
+						for _, v := range strings.Split(s, ",") {
+							weekdays = append(weekdays, strings.TrimSpace(v))
+						}
+					}
+					return weekdays
+				},
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+		return nil
+	})
+}
+
+func (s *KrdsService) ModifyKrdsBackupPolicy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	modifyCall, err := s.modifyKrdsBackupPolicyCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(modifyCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) modifyKrdsBackupPolicyCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"backup_retention_period": {},
+		"preferred_backup_weekdays": {
+			mapping: "PreferredBackupPeriod",
+			FieldReqFunc: func(i interface{}, mapping string, _ map[string]string, index int, _ string, req *map[string]interface{}) (int, error) {
+				var weekdays []string
+				for _, v := range i.(*schema.Set).List() {
+					weekdays = append(weekdays, v.(string))
+				}
+				(*req)[mapping] = strings.Join(weekdays, ",")
+				return index, nil
+			},
+		},
+		"binlog_retention_period":              {},
+		"cross_region_backup_enabled":          {Ignore: true},
+		"cross_region_backup_region":           {},
+		"cross_region_backup_retention_period": {},
+	}
+	// the backup policy is modified as a whole, so all the configured fields are sent every time
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+	params["DBInstanceIdentifier"] = d.Get("db_instance_identifier")
+	params["CrossRegionBackupEnabled"] = d.Get("cross_region_backup_enabled")
+
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyDBBackupPolicy",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			instanceId := d.Get("db_instance_identifier").(string)
+			err = checkKrdsInstanceState(d, client, instanceId, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return resp, err
+			}
+			// the backup time window is owned by ksyun_krds, send the current one so that it is kept as is
+			krdsService := KrdsService{client}
+			policy, err := krdsService.ReadKrdsBackupPolicy(d, instanceId)
+			if err != nil {
+				return resp, err
+			}
+			(*call.param)["PreferredBackupTime"] = policy["PreferredBackupTime"]
+			conn := client.krdsconn
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			resp, err = conn.ModifyDBBackupPolicy(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			d.SetId(d.Get("db_instance_identifier").(string))
+			return err
+		},
+	}
+	return callback, err
+}
+
+// checkKrdsBackupPolicyDiff validates the combination of the backup policy during plan.
+func checkKrdsBackupPolicyDiff(diff *schema.ResourceDiff, meta interface{}) (err error) {
+	backupRetention, backupOk := diff.GetOk("backup_retention_period")
+	binlogRetention, binlogOk := diff.GetOk("binlog_retention_period")
+	if backupOk && binlogOk && binlogRetention.(int) > backupRetention.(int) {
+		return fmt.Errorf("binlog_retention_period %d can not be greater than backup_retention_period %d",
+			binlogRetention, backupRetention)
+	}
+
+	if weekdays, ok := diff.GetOk("preferred_backup_weekdays"); ok && weekdays.(*schema.Set).Len() < 2 {
+		return fmt.Errorf("preferred_backup_weekdays must contain at least 2 days")
+	}
+
+	enabled, _ := diff.Get("cross_region_backup_enabled").(bool)
+	region, regionOk := diff.GetOk("cross_region_backup_region")
+	if !enabled && regionOk && diff.HasChange("cross_region_backup_region") {
+		return fmt.Errorf("cross_region_backup_region only works when cross_region_backup_enabled is true")
+	}
+	if enabled {
+		if !regionOk && diff.NewValueKnown("cross_region_backup_region") {
+			return fmt.Errorf("cross_region_backup_region is required when cross_region_backup_enabled is true")
+		}
+		if client, ok := meta.(*KsyunClient); ok && regionOk && region.(string) == client.region {
+			return fmt.Errorf("cross_region_backup_region can not be the region of the instance %s", client.region)
+		}
+	}
+	return err
+}
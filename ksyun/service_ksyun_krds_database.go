@@ -0,0 +1,246 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type KrdsService struct {
+	client *KsyunClient
+}
+
+func (s *KrdsService) ReadKrdsDatabases(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.krdsconn
+	action := "DescribeInstanceDatabases"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("Data.InstanceDatabases", *resp)
+	if err != nil {
+		return data, err
+	}
+	return If2Slice(results)
+}
+
+func (s *KrdsService) ReadKrdsDatabase(d *schema.ResourceData, instanceId string, name string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if instanceId == "" {
+		instanceId = d.Get("db_instance_identifier").(string)
+	}
+	if name == "" {
+		name = d.Get("database_name").(string)
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"InstanceDatabaseName": name,
+	}
+	results, err = s.ReadKrdsDatabases(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if m, ok := v.(map[string]interface{}); ok && m["InstanceDatabaseName"] == name {
+			data = m
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Krds database %s:%s not exist ", instanceId, name)
+	}
+	return data, err
+}
+
+func (s *KrdsService) ReadAndSetKrdsDatabase(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKrdsDatabase(d, "", "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading krds database %q, %s", d.Id(), callErr))
+			}
+		}
+		extra := map[string]SdkResponseMapping{
+			"InstanceDatabaseName": {
+				Field: "database_name",
+			},
+			"InstanceDatabaseCharacterSet": {
+				Field: "character_set",
+			},
+			"InstanceDatabaseCollationRule": {
+				Field: "collation",
+			},
+			"InstanceDatabaseDescription": {
+				Field: "description",
+			},
+			"InstanceDatabaseStatus": {
+				Field: "status",
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+		return nil
+	})
+}
+
+func (s *KrdsService) CreateKrdsDatabase(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKrdsDatabaseCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) ModifyKrdsDatabase(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	modifyCall, err := s.modifyKrdsDatabaseCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(modifyCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) RemoveKrdsDatabase(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeKrdsDatabaseCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) createKrdsDatabaseCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {
+			mapping: "DBInstanceIdentifier",
+		},
+		"database_name": {
+			mapping: "InstanceDatabaseName",
+		},
+		"character_set": {
+			mapping: "InstanceDatabaseCharacterSet",
+		},
+		"collation": {
+			mapping: "InstanceDatabaseCollationRule",
+		},
+		"description": {
+			mapping: "InstanceDatabaseDescription",
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateInstanceDatabase",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			instanceId := d.Get("db_instance_identifier").(string)
+			err = checkKrdsInstanceState(d, client, instanceId, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			d.SetId(strings.Join([]string{d.Get("db_instance_identifier").(string), d.Get("database_name").(string)}, ":"))
+			return checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) modifyKrdsDatabaseCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("description") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DBInstanceIdentifier":        d.Get("db_instance_identifier"),
+		"InstanceDatabaseName":        d.Get("database_name"),
+		"InstanceDatabaseDescription": d.Get("description"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyInstanceDatabaseInfo",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) removeKrdsDatabaseCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"InstanceDatabaseName": d.Get("database_name"),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteInstanceDatabase",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadKrdsDatabase(d, "", "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading krds database when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutDelete))
+		},
+	}
+	return callback, err
+}
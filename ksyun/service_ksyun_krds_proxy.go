@@ -0,0 +1,298 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *KrdsService) ReadKrdsProxies(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.krdsconn
+	action := "DescribeDBProxies"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("Data.DBProxies", *resp)
+	if err != nil {
+		return data, err
+	}
+	return If2Slice(results)
+}
+
+func (s *KrdsService) ReadKrdsProxy(d *schema.ResourceData, proxyId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if proxyId == "" {
+		proxyId = d.Id()
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"DBProxyIdentifier":    proxyId,
+	}
+	results, err = s.ReadKrdsProxies(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if m, ok := v.(map[string]interface{}); ok && m["DBProxyIdentifier"] == proxyId {
+			data = m
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("Krds proxy %s not exist ", proxyId)
+	}
+	return data, err
+}
+
+func (s *KrdsService) ReadAndSetKrdsProxy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadKrdsProxy(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading krds proxy %q, %s", d.Id(), callErr))
+			}
+		}
+		extra := map[string]SdkResponseMapping{
+			"DBProxyName": {
+				Field: "proxy_name",
+			},
+			"DBProxyStatus": {
+				Field: "status",
+			},
+			"DBInstanceIdentifier": {
+				Field: "db_instance_identifier",
+			},
+			"ReadOnlyInstances": {
+				Field: "read_only_instances",
+				FieldRespFunc: func(i interface{}) interface{} {
+					var instances []interface{}
+					if items, ok := i.([]interface{}); ok {
+						for _, item := range items {
+							if m, ok := item.(map[string]interface{}); ok {
+								instances = append(instances, map[string]interface{}{
+									"db_instance_identifier": m["DBInstanceIdentifier"],
+									"weight":                 m["Weight"],
+									"status":                 m["Status"],
+								})
+							}
+						}
+					}
+					return instances
+				},
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+		return nil
+	})
+}
+
+func (s *KrdsService) CreateKrdsProxy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createKrdsProxyCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) ModifyKrdsProxy(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	modifyCall, err := s.modifyKrdsProxyCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(modifyCall)
+
+	return apiProcess.Run()
+}
+
+func (s *KrdsService) RemoveKrdsProxy(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeKrdsProxyCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+// krdsProxyParams builds the parameters shared by creating and modifying the proxy,
+// the read only instances are always sent as a whole.
+func krdsProxyParams(d *schema.ResourceData, r *schema.Resource) (params map[string]interface{}, err error) {
+	transform := map[string]SdkReqTransform{
+		"proxy_name": {
+			mapping: "DBProxyName",
+		},
+		"delay_threshold":     {},
+		"least_replicas":      {},
+		"read_only_instances": {Ignore: true},
+		"auto_remove_delayed": {Ignore: true},
+		"db_instance_identifier": {
+			mapping: "DBInstanceIdentifier",
+		},
+	}
+	params, err = SdkRequestAutoMapping(d, r, false, transform, nil)
+	if err != nil {
+		return params, err
+	}
+	params["AutoRemoveDelayed"] = d.Get("auto_remove_delayed")
+	for i, v := range d.Get("read_only_instances").(*schema.Set).List() {
+		instance := v.(map[string]interface{})
+		prefix := "ReadOnlyInstances." + strconv.Itoa(i+1)
+		params[prefix+".DBInstanceIdentifier"] = instance["db_instance_identifier"]
+		params[prefix+".Weight"] = instance["weight"]
+	}
+	return params, err
+}
+
+func (s *KrdsService) createKrdsProxyCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	params, err := krdsProxyParams(d, r)
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateDBProxy",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("Data.DBProxy.DBProxyIdentifier", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			return s.checkKrdsProxyState(d, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) modifyKrdsProxyCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	if !d.HasChanges("proxy_name", "delay_threshold", "least_replicas", "auto_remove_delayed", "read_only_instances") {
+		return callback, err
+	}
+	params, err := krdsProxyParams(d, r)
+	if err != nil {
+		return callback, err
+	}
+	params["DBProxyIdentifier"] = d.Id()
+
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyDBProxy",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.checkKrdsProxyState(d, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate))
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) removeKrdsProxyCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DBProxyIdentifier": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteDBProxy",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.krdsconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadKrdsProxy(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading krds proxy when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return checkKrdsInstanceState(d, client, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutDelete))
+		},
+	}
+	return callback, err
+}
+
+func (s *KrdsService) checkKrdsProxyState(d *schema.ResourceData, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     target,
+		Refresh:    s.krdsProxyStateRefreshFunc(d, []string{"ERROR"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *KrdsService) krdsProxyStateRefreshFunc(d *schema.ResourceData, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadKrdsProxy(d, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status, err := getSdkValue("DBProxyStatus", data)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, v := range failStates {
+			if v == status.(string) {
+				return nil, "", fmt.Errorf("krds proxy status error, status:%v", status)
+			}
+		}
+		return data, status.(string), nil
+	}
+}
@@ -188,6 +188,9 @@ func createMongodbInstance(d *schema.ResourceData, meta interface{}, r *schema.R
 		"cidrs": {
 			Ignore: true,
 		},
+		"backup_id": {
+			mapping: "SnapshotId",
+		},
 	}
 	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
 		onlyTransform: false,
@@ -0,0 +1,180 @@
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func readMongodbBackups(d *schema.ResourceData, meta interface{}, instanceId string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := meta.(*KsyunClient).mongodbconn
+	action := "DescribeMongoDBSnapshot"
+	req := map[string]interface{}{
+		"InstanceId": instanceId,
+	}
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.DescribeMongoDBSnapshot(&req)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("MongoDBSnapshotResult", *resp)
+	if err != nil || results == nil {
+		return data, err
+	}
+	return If2Slice(results)
+}
+
+func readMongodbBackup(d *schema.ResourceData, meta interface{}, backupId string) (data map[string]interface{}, err error) {
+	if backupId == "" {
+		backupId = d.Id()
+	}
+	results, err := readMongodbBackups(d, meta, d.Get("instance_id").(string))
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if m, ok := v.(map[string]interface{}); ok && m["SnapshotId"] == backupId {
+			return m, err
+		}
+	}
+	return data, fmt.Errorf("MongoDB backup %s not found", backupId)
+}
+
+func createMongodbBackup(d *schema.ResourceData, meta interface{}) (err error) {
+	var (
+		resp *map[string]interface{}
+		id   interface{}
+	)
+	instanceId := d.Get("instance_id").(string)
+	err = checkMongodbState(d, meta, instanceId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	conn := meta.(*KsyunClient).mongodbconn
+	action := "CreateMongoDBSnapshot"
+	req := map[string]interface{}{
+		"InstanceId": instanceId,
+	}
+	if v, ok := d.GetOk("backup_name"); ok {
+		req["Name"] = v
+	}
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.CreateMongoDBSnapshot(&req)
+	if err != nil {
+		return err
+	}
+	id, err = getSdkValue("MongoDBSnapshotResult.SnapshotId", *resp)
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return fmt.Errorf("no snapshot id returned by %s", action)
+	}
+	d.SetId(id.(string))
+	return checkMongodbBackupState(d, meta, d.Timeout(schema.TimeoutCreate))
+}
+
+func removeMongodbBackup(d *schema.ResourceData, meta interface{}) (err error) {
+	conn := meta.(*KsyunClient).mongodbconn
+	action := "DeleteMongoDBSnapshot"
+	req := map[string]interface{}{
+		"InstanceId": d.Get("instance_id"),
+		"SnapshotId": d.Id(),
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		logger.Debug(logger.ReqFormat, action, req)
+		_, err = conn.DeleteMongoDBSnapshot(&req)
+		if err == nil {
+			return nil
+		}
+		_, readErr := readMongodbBackup(d, meta, "")
+		if readErr != nil && canNotFoundMongodbError(readErr) {
+			return nil
+		}
+		return resource.RetryableError(err)
+	})
+}
+
+func checkMongodbBackupState(d *schema.ResourceData, meta interface{}, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     []string{"success"},
+		Refresh:    mongodbBackupStateRefreshFunc(d, meta, []string{"failed"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func mongodbBackupStateRefreshFunc(d *schema.ResourceData, meta interface{}, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := readMongodbBackup(d, meta, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status := fmt.Sprintf("%v", data["Status"])
+		for _, v := range failStates {
+			if v == status {
+				return nil, "", fmt.Errorf("backup status error, status:%v", status)
+			}
+		}
+		return data, status, nil
+	}
+}
+
+func readMongodbBackupPolicy(d *schema.ResourceData, meta interface{}, r *schema.Resource) (err error) {
+	data, err := readMongodbInstance(d, meta, d.Get("instance_id").(string))
+	if err != nil {
+		return err
+	}
+	policy := map[string]interface{}{}
+	for _, k := range []string{"TimingSwitch", "Timezone", "TimeCycle"} {
+		if v, ok := data[k]; ok {
+			policy[k] = v
+		}
+	}
+	SdkResponseAutoResourceData(d, r, policy, nil)
+	return err
+}
+
+func modifyMongodbBackupPolicy(d *schema.ResourceData, meta interface{}, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"timing_switch": {},
+		"timezone":      {},
+		"time_cycle":    {},
+	}
+	req, err := SdkRequestAutoMapping(d, r, d.Id() != "", transform, nil)
+	if err != nil {
+		return err
+	}
+	if len(req) == 0 {
+		return err
+	}
+	// the api requires the whole policy
+	req["InstanceId"] = d.Get("instance_id")
+	req["TimingSwitch"] = d.Get("timing_switch")
+	if v, ok := d.GetOk("timezone"); ok {
+		req["Timezone"] = v
+	}
+	if v, ok := d.GetOk("time_cycle"); ok {
+		req["TimeCycle"] = v
+	}
+	err = checkMongodbState(d, meta, d.Get("instance_id").(string), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	conn := meta.(*KsyunClient).mongodbconn
+	action := "SetMongoDBTimingSnapshot"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err = conn.SetMongoDBTimingSnapshot(&req)
+	return err
+}
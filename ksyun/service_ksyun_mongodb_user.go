@@ -0,0 +1,117 @@
+package ksyun
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+// mongodbUserCall calls the user api of the MongoDB instance, which is not provided by the sdk.
+func mongodbUserCall(d *schema.ResourceData, meta interface{}, action string, req map[string]interface{}) (*map[string]interface{}, error) {
+	conn := meta.(*KsyunClient).mongodbconn
+	req["InstanceId"] = d.Get("instance_id")
+	req["Database"] = d.Get("database")
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := ksyunOpenApiCall(conn.Client, action, &req)
+	if err != nil {
+		return resp, err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	return resp, err
+}
+
+func readMongodbUser(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	userName := d.Get("user_name").(string)
+	resp, err := mongodbUserCall(d, meta, "DescribeMongoDBUsers", map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	results, err := getSdkValue("MongoDBUserResult", *resp)
+	if err != nil {
+		return nil, err
+	}
+	users, _ := results.([]interface{})
+	for _, v := range users {
+		if item, ok := v.(map[string]interface{}); ok && item["UserName"] == userName {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("mongodb user %s not found", userName)
+}
+
+// mongodbUserRoles converts the roles of the user to the schema.
+func mongodbUserRoles(item map[string]interface{}) []interface{} {
+	var roles []interface{}
+	results, _ := item["Roles"].([]interface{})
+	for _, v := range results {
+		if r, ok := v.(map[string]interface{}); ok {
+			roles = append(roles, map[string]interface{}{
+				"role": r["Role"],
+				"db":   r["Db"],
+			})
+		}
+	}
+	return roles
+}
+
+func mongodbUserRolesReq(d *schema.ResourceData, req map[string]interface{}) {
+	for i, v := range d.Get("roles").(*schema.Set).List() {
+		role := v.(map[string]interface{})
+		req["Roles."+strconv.Itoa(i+1)+".Role"] = role["role"]
+		req["Roles."+strconv.Itoa(i+1)+".Db"] = role["db"]
+	}
+}
+
+func createMongodbUser(d *schema.ResourceData, meta interface{}) error {
+	instanceId := d.Get("instance_id").(string)
+	err := checkMongodbState(d, meta, instanceId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{
+		"UserName": d.Get("user_name"),
+		"Password": d.Get("password"),
+	}
+	mongodbUserRolesReq(d, req)
+	_, err = mongodbUserCall(d, meta, "CreateMongoDBUser", req)
+	if err != nil {
+		return err
+	}
+	d.SetId(strings.Join([]string{instanceId, d.Get("database").(string), d.Get("user_name").(string)}, ":"))
+	return nil
+}
+
+func modifyMongodbUser(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("password") {
+		req := map[string]interface{}{
+			"UserName": d.Get("user_name"),
+			"Password": d.Get("password"),
+		}
+		_, err := mongodbUserCall(d, meta, "ResetMongoDBUserPassword", req)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("roles") {
+		req := map[string]interface{}{
+			"UserName": d.Get("user_name"),
+		}
+		mongodbUserRolesReq(d, req)
+		_, err := mongodbUserCall(d, meta, "ModifyMongoDBUserRoles", req)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeMongodbUser(d *schema.ResourceData, meta interface{}) error {
+	req := map[string]interface{}{
+		"UserName": d.Get("user_name"),
+	}
+	_, err := mongodbUserCall(d, meta, "DeleteMongoDBUser", req)
+	return err
+}
@@ -0,0 +1,445 @@
+package ksyun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+const rabbitmqManagementPort = 15672
+
+// rabbitmqManagementClient talks to the http management api of the rabbitmq instance,
+// which is the same api used by rabbitmqadmin.
+type rabbitmqManagementClient struct {
+	endpoint   string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// rabbitmqManagementSchema returns the fields used to connect to the management api,
+// which are shared by all the resources inside the rabbitmq instance.
+func rabbitmqManagementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The id of the rabbitmq instance. The management api is reached via `web_eip` of the instance, or `web_vip` if the eip is not allocated. One of `instance_id` and `endpoint` is required.",
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The address of the management api, such as `http://10.0.0.1:15672`. It takes precedence over `instance_id`.",
+		},
+		"admin_user": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The user to login the management api.",
+		},
+		"admin_password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The password to login the management api.",
+		},
+	}
+}
+
+func newRabbitmqManagementClient(d *schema.ResourceData, meta interface{}) (*rabbitmqManagementClient, error) {
+	endpoint := d.Get("endpoint").(string)
+	if endpoint == "" {
+		instanceId := d.Get("instance_id").(string)
+		if instanceId == "" {
+			return nil, fmt.Errorf("one of `instance_id` and `endpoint` must be set")
+		}
+		data, err := readRabbitmqInstance(d, meta, instanceId)
+		if err != nil {
+			return nil, err
+		}
+		host, _ := data["WebEip"].(string)
+		if host == "" {
+			host, _ = data["WebVip"].(string)
+		}
+		if host == "" {
+			return nil, fmt.Errorf("the management address of rabbitmq instance %s is not available", instanceId)
+		}
+		endpoint = fmt.Sprintf("http://%s:%d", host, rabbitmqManagementPort)
+	}
+	return &rabbitmqManagementClient{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		username: d.Get("admin_user").(string),
+		password: d.Get("admin_password").(string),
+		httpClient: &http.Client{
+			Timeout: 1 * time.Minute,
+		},
+	}, nil
+}
+
+// call sends the request to the management api, the response body is decoded into out if it is not nil.
+func (c *rabbitmqManagementClient) call(method string, path string, body interface{}, out interface{}) (*http.Response, error) {
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req, err := http.NewRequest(method, c.endpoint+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", "application/json")
+	logger.Debug(logger.ReqFormat, method+" "+path, body)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return resp, fmt.Errorf("rabbitmq %s not found", path)
+	}
+	if resp.StatusCode >= 400 {
+		return resp, fmt.Errorf("rabbitmq management api %s %s error, status: %d, body: %s", method, path, resp.StatusCode, string(content))
+	}
+	if out != nil && len(content) > 0 {
+		err = json.Unmarshal(content, out)
+	}
+	return resp, err
+}
+
+// rabbitmqPath builds the api path, each segment is escaped so that the default vhost `/` works.
+func rabbitmqPath(segments ...string) string {
+	var escaped []string
+	for _, s := range segments {
+		escaped = append(escaped, url.PathEscape(s))
+	}
+	return "/api/" + strings.Join(escaped, "/")
+}
+
+// rabbitmqArgumentsReq converts the arguments to the request, numbers and booleans are
+// sent as is since rabbitmq rejects them as strings, such as `x-message-ttl`.
+func rabbitmqArgumentsReq(arguments map[string]interface{}) map[string]interface{} {
+	req := make(map[string]interface{})
+	for k, v := range arguments {
+		s := v.(string)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			req[k] = i
+		} else if b, err := strconv.ParseBool(s); err == nil {
+			req[k] = b
+		} else {
+			req[k] = s
+		}
+	}
+	return req
+}
+
+// rabbitmqArgumentsResp converts the arguments back to strings, numbers are decoded as float64 and
+// must not be formatted with an exponent, otherwise `3600000` becomes `3.6e+06` and forces a replacement.
+func rabbitmqArgumentsResp(arguments interface{}) map[string]interface{} {
+	resp := make(map[string]interface{})
+	if m, ok := arguments.(map[string]interface{}); ok {
+		for k, v := range m {
+			if f, ok := v.(float64); ok {
+				resp[k] = strconv.FormatFloat(f, 'f', -1, 64)
+			} else {
+				resp[k] = fmt.Sprintf("%v", v)
+			}
+		}
+	}
+	return resp
+}
+
+func createRabbitmqVhost(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	body := map[string]interface{}{
+		"tracing": d.Get("tracing"),
+	}
+	_, err = c.call(http.MethodPut, rabbitmqPath("vhosts", name), body, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(name)
+	return nil
+}
+
+func readRabbitmqVhost(d *schema.ResourceData, meta interface{}) (data map[string]interface{}, err error) {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return data, err
+	}
+	_, err = c.call(http.MethodGet, rabbitmqPath("vhosts", d.Id()), nil, &data)
+	return data, err
+}
+
+func removeRabbitmqVhost(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	_, err = c.call(http.MethodDelete, rabbitmqPath("vhosts", d.Id()), nil, nil)
+	return err
+}
+
+func putRabbitmqUser(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	var tags []string
+	for _, v := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, v.(string))
+	}
+	sort.Strings(tags)
+	body := map[string]interface{}{
+		"password": d.Get("password"),
+		"tags":     strings.Join(tags, ","),
+	}
+	_, err = c.call(http.MethodPut, rabbitmqPath("users", name), body, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(name)
+	return nil
+}
+
+func readRabbitmqUser(d *schema.ResourceData, meta interface{}) (data map[string]interface{}, err error) {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return data, err
+	}
+	_, err = c.call(http.MethodGet, rabbitmqPath("users", d.Id()), nil, &data)
+	return data, err
+}
+
+// rabbitmqUserTags returns the tags of the user, which is a comma separated string
+// before rabbitmq 3.9 and an array since then.
+func rabbitmqUserTags(data map[string]interface{}) []interface{} {
+	var tags []interface{}
+	switch v := data["tags"].(type) {
+	case string:
+		for _, t := range strings.Split(v, ",") {
+			if t != "" {
+				tags = append(tags, t)
+			}
+		}
+	case []interface{}:
+		tags = v
+	}
+	return tags
+}
+
+func removeRabbitmqUser(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	_, err = c.call(http.MethodDelete, rabbitmqPath("users", d.Id()), nil, nil)
+	return err
+}
+
+func putRabbitmqPermission(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	user := d.Get("user").(string)
+	vhost := d.Get("vhost").(string)
+	body := map[string]interface{}{
+		"configure": d.Get("configure"),
+		"write":     d.Get("write"),
+		"read":      d.Get("read"),
+	}
+	_, err = c.call(http.MethodPut, rabbitmqPath("permissions", vhost, user), body, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(user + "@" + vhost)
+	return nil
+}
+
+func readRabbitmqPermission(d *schema.ResourceData, meta interface{}) (data map[string]interface{}, err error) {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return data, err
+	}
+	_, err = c.call(http.MethodGet, rabbitmqPath("permissions", d.Get("vhost").(string), d.Get("user").(string)), nil, &data)
+	return data, err
+}
+
+func removeRabbitmqPermission(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	_, err = c.call(http.MethodDelete, rabbitmqPath("permissions", d.Get("vhost").(string), d.Get("user").(string)), nil, nil)
+	return err
+}
+
+func createRabbitmqExchange(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	vhost := d.Get("vhost").(string)
+	body := map[string]interface{}{
+		"type":        d.Get("type"),
+		"durable":     d.Get("durable"),
+		"auto_delete": d.Get("auto_delete"),
+		"internal":    d.Get("internal"),
+		"arguments":   rabbitmqArgumentsReq(d.Get("arguments").(map[string]interface{})),
+	}
+	_, err = c.call(http.MethodPut, rabbitmqPath("exchanges", vhost, name), body, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(name + "@" + vhost)
+	return nil
+}
+
+func readRabbitmqExchange(d *schema.ResourceData, meta interface{}) (data map[string]interface{}, err error) {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return data, err
+	}
+	_, err = c.call(http.MethodGet, rabbitmqPath("exchanges", d.Get("vhost").(string), d.Get("name").(string)), nil, &data)
+	return data, err
+}
+
+func removeRabbitmqExchange(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	_, err = c.call(http.MethodDelete, rabbitmqPath("exchanges", d.Get("vhost").(string), d.Get("name").(string)), nil, nil)
+	return err
+}
+
+func createRabbitmqQueue(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+	vhost := d.Get("vhost").(string)
+	body := map[string]interface{}{
+		"durable":     d.Get("durable"),
+		"auto_delete": d.Get("auto_delete"),
+		"arguments":   rabbitmqArgumentsReq(d.Get("arguments").(map[string]interface{})),
+	}
+	_, err = c.call(http.MethodPut, rabbitmqPath("queues", vhost, name), body, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(name + "@" + vhost)
+	return nil
+}
+
+func readRabbitmqQueue(d *schema.ResourceData, meta interface{}) (data map[string]interface{}, err error) {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return data, err
+	}
+	_, err = c.call(http.MethodGet, rabbitmqPath("queues", d.Get("vhost").(string), d.Get("name").(string)), nil, &data)
+	return data, err
+}
+
+func removeRabbitmqQueue(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	_, err = c.call(http.MethodDelete, rabbitmqPath("queues", d.Get("vhost").(string), d.Get("name").(string)), nil, nil)
+	return err
+}
+
+// rabbitmqBindingPath returns the path of the bindings between the source exchange and the destination.
+func rabbitmqBindingPath(d *schema.ResourceData) []string {
+	destinationType := "q"
+	if d.Get("destination_type").(string) == "exchange" {
+		destinationType = "e"
+	}
+	return []string{"bindings", d.Get("vhost").(string), "e", d.Get("source").(string), destinationType, d.Get("destination").(string)}
+}
+
+func createRabbitmqBinding(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{
+		"routing_key": d.Get("routing_key"),
+		"arguments":   rabbitmqArgumentsReq(d.Get("arguments").(map[string]interface{})),
+	}
+	resp, err := c.call(http.MethodPost, rabbitmqPath(rabbitmqBindingPath(d)...), body, nil)
+	if err != nil {
+		return err
+	}
+	// the location is the path of the new binding, which ends with the properties key
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return fmt.Errorf("no location returned for the binding")
+	}
+	propertiesKey, err := url.PathUnescape(location[strings.LastIndex(location, "/")+1:])
+	if err != nil {
+		return err
+	}
+	err = d.Set("properties_key", propertiesKey)
+	if err != nil {
+		return err
+	}
+	d.SetId(strings.TrimPrefix(rabbitmqPath(append(rabbitmqBindingPath(d), propertiesKey)...), "/api/bindings/"))
+	return nil
+}
+
+func readRabbitmqBinding(d *schema.ResourceData, meta interface{}) (data map[string]interface{}, err error) {
+	var bindings []map[string]interface{}
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return data, err
+	}
+	_, err = c.call(http.MethodGet, rabbitmqPath(rabbitmqBindingPath(d)...), nil, &bindings)
+	if err != nil {
+		return data, err
+	}
+	for _, b := range bindings {
+		if b["properties_key"] == d.Get("properties_key") {
+			return b, nil
+		}
+	}
+	return data, fmt.Errorf("rabbitmq binding %s not found", d.Id())
+}
+
+func removeRabbitmqBinding(d *schema.ResourceData, meta interface{}) error {
+	c, err := newRabbitmqManagementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	_, err = c.call(http.MethodDelete, rabbitmqPath(append(rabbitmqBindingPath(d), d.Get("properties_key").(string))...), nil, nil)
+	return err
+}
@@ -0,0 +1,217 @@
+package ksyun
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// rabbitmqManagementStub is an in-memory rabbitmq management api which keeps the objects by path.
+type rabbitmqManagementStub struct {
+	sync.Mutex
+	objects map[string]map[string]interface{}
+}
+
+func (s *rabbitmqManagementStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if user, password, ok := r.BasicAuth(); !ok || user != "root" || password != "Shiwo1101" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	path := r.URL.EscapedPath()
+	segments := strings.Split(path, "/")
+	name, _ := url.PathUnescape(segments[len(segments)-1])
+	switch r.Method {
+	case http.MethodPut:
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		body["name"] = name
+		s.objects[path] = body
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodPost:
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		key := body["routing_key"].(string)
+		if key == "" {
+			key = "~"
+		}
+		body["properties_key"] = key
+		s.objects[path+"/"+url.PathEscape(key)] = body
+		w.Header().Set("Location", path+"/"+url.PathEscape(key))
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet:
+		if strings.HasPrefix(path, "/api/bindings/") {
+			bindings := []interface{}{}
+			for k, v := range s.objects {
+				if strings.HasPrefix(k, path+"/") {
+					bindings = append(bindings, v)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(bindings)
+			return
+		}
+		if v, ok := s.objects[path]; ok {
+			_ = json.NewEncoder(w).Encode(v)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case http.MethodDelete:
+		if _, ok := s.objects[path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestKsyunRabbitmqManagement_stub(t *testing.T) {
+	stub := &rabbitmqManagementStub{objects: map[string]map[string]interface{}{}}
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(stub.objects) != 0 {
+				return fmt.Errorf("rabbitmq objects are not removed: %v", stub.objects)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccRabbitmqManagementConfig, srv.URL, "management", ".*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_vhost.default", "id", "orders"),
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_user.default", "tags.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_permission.default", "id", "orders-app@orders"),
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_exchange.default", "type", "topic"),
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_queue.default", "arguments.x-message-ttl", "60000"),
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_binding.default", "properties_key", "orders.created.#"),
+					func(s *terraform.State) error {
+						queue := stub.objects["/api/queues/orders/orders.created"]
+						if _, ok := queue["arguments"].(map[string]interface{})["x-message-ttl"].(float64); !ok {
+							return fmt.Errorf("x-message-ttl should be sent as a number: %v", queue)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccRabbitmqManagementConfig, srv.URL, "monitoring", "^orders\\\\..*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_user.default", "tags.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_rabbitmq_permission.default", "read", "^orders\\..*"),
+				),
+			},
+		},
+	})
+}
+
+func TestRabbitmqPath(t *testing.T) {
+	if p := rabbitmqPath("exchanges", "/", "amq.topic"); p != "/api/exchanges/%2F/amq.topic" {
+		t.Fatalf("unexpected path %s", p)
+	}
+}
+
+func TestRabbitmqArgumentsRoundTrip(t *testing.T) {
+	arguments := map[string]interface{}{
+		"x-message-ttl":            "3600000",
+		"x-max-length":             "10",
+		"x-queue-mode":             "lazy",
+		"x-single-active-consumer": "true",
+	}
+	body, err := json.Marshal(rabbitmqArgumentsReq(arguments))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded interface{}
+	if err = json.Unmarshal(body, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	resp := rabbitmqArgumentsResp(decoded)
+	for k, v := range arguments {
+		if resp[k] != v {
+			t.Fatalf("argument %s should be %v after the round trip, got %v", k, v, resp[k])
+		}
+	}
+}
+
+const testAccRabbitmqManagementConfig = `
+provider "ksyun" {
+  region     = "cn-beijing-6"
+  access_key = "ak"
+  secret_key = "sk"
+}
+
+locals {
+  endpoint = "%s"
+}
+
+resource "ksyun_rabbitmq_vhost" "default" {
+  endpoint       = local.endpoint
+  admin_user     = "root"
+  admin_password = "Shiwo1101"
+  name           = "orders"
+}
+
+resource "ksyun_rabbitmq_user" "default" {
+  endpoint       = local.endpoint
+  admin_user     = "root"
+  admin_password = "Shiwo1101"
+  name           = "orders-app"
+  password       = "Shiwo1102"
+  tags           = distinct(["management", "%s"])
+}
+
+resource "ksyun_rabbitmq_permission" "default" {
+  endpoint       = local.endpoint
+  admin_user     = "root"
+  admin_password = "Shiwo1101"
+  user           = ksyun_rabbitmq_user.default.name
+  vhost          = ksyun_rabbitmq_vhost.default.name
+  configure      = ".*"
+  write          = ".*"
+  read           = "%s"
+}
+
+resource "ksyun_rabbitmq_exchange" "default" {
+  endpoint       = local.endpoint
+  admin_user     = "root"
+  admin_password = "Shiwo1101"
+  vhost          = ksyun_rabbitmq_vhost.default.name
+  name           = "orders"
+  type           = "topic"
+}
+
+resource "ksyun_rabbitmq_queue" "default" {
+  endpoint       = local.endpoint
+  admin_user     = "root"
+  admin_password = "Shiwo1101"
+  vhost          = ksyun_rabbitmq_vhost.default.name
+  name           = "orders.created"
+  arguments = {
+    "x-message-ttl" = "60000"
+  }
+}
+
+resource "ksyun_rabbitmq_binding" "default" {
+  endpoint         = local.endpoint
+  admin_user       = "root"
+  admin_password   = "Shiwo1101"
+  vhost            = ksyun_rabbitmq_vhost.default.name
+  source           = ksyun_rabbitmq_exchange.default.name
+  destination      = ksyun_rabbitmq_queue.default.name
+  destination_type = "queue"
+  routing_key      = "orders.created.#"
+}
+`
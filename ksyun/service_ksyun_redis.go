@@ -81,7 +81,18 @@ func resourceRedisInstanceParamRead(d *schema.ResourceData, meta interface{}) er
 		defaultResult[param["name"].(string)] = fmt.Sprintf("%v", param["defaultValue"])
 	}
 	localParams := d.Get("parameters").(map[string]interface{})
-	if len(localParams) < 1 {
+	_, hasGroup := d.GetOk("parameter_group_id")
+	if hasGroup {
+		// the values come from the parameter group, mark the group as changed when they drift away from it
+		drifted, err := redisInstanceParameterGroupDrifted(d, meta, result)
+		if err != nil {
+			return fmt.Errorf("error on reading parameter group of instance %q, %s", d.Id(), err)
+		}
+		if drifted {
+			_ = d.Set("parameter_group_id", "")
+		}
+	}
+	if len(localParams) < 1 && !hasGroup {
 		for k, v := range result {
 			if v1, ok := defaultResult[k]; ok {
 				if v != v1 {
@@ -330,6 +341,11 @@ func modifyRedisInstanceSpec(d *schema.ResourceData, meta interface{}) error {
 		resp *map[string]interface{}
 	)
 
+	// the shards of the SelfDefineCluster are changed online, and the capacity follows the shards
+	if d.Get("mode").(int) == 3 && (d.HasChange("shard_num") || d.HasChange("shard_size")) {
+		return reshardRedisInstance(d, meta)
+	}
+
 	transform := map[string]SdkReqTransform{
 		"capacity":   {},
 		"shard_size": {},
@@ -367,6 +383,41 @@ func modifyRedisInstanceSpec(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+func reshardRedisInstance(d *schema.ResourceData, meta interface{}) error {
+	var (
+		err  error
+		resp *map[string]interface{}
+	)
+	req := map[string]interface{}{
+		"CacheId":   d.Id(),
+		"ShardNum":  d.Get("shard_num"),
+		"ShardSize": d.Get("shard_size"),
+	}
+	action := "ReshardCacheCluster"
+	integrationAzConf := &IntegrationRedisAzConf{
+		resourceData: d,
+		client:       meta.(*KsyunClient),
+		req:          &req,
+		field:        "available_zone",
+		requestFunc: func() (*map[string]interface{}, error) {
+			conn := meta.(*KsyunClient).kcsv1conn
+			return ksyunOpenApiCall(conn.Client, action, &req)
+		},
+	}
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = integrationAzConf.integrationRedisAz()
+	if err != nil {
+		return fmt.Errorf("error on ReshardCacheCluster instance %q, %s", d.Id(), err)
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	// data migration between the shards may take a long time, the instance keeps serving until it is finished
+	err = checkRedisInstanceStatus(d, meta, d.Timeout(schema.TimeoutUpdate), "")
+	if err != nil {
+		return fmt.Errorf("error on ReshardCacheCluster instance %q, %s", d.Id(), err)
+	}
+	return err
+}
+
 func modifyRedisInstanceSg(d *schema.ResourceData, meta interface{}, isUpdate bool) error {
 	var (
 		err  error
@@ -520,7 +571,19 @@ func resourceRedisInstanceParameterCheckAndPrepare(d *schema.ResourceData, meta
 	req := make(map[string]interface{})
 
 	parameters := make(map[string]string)
-	if !isUpdate || (isUpdate && d.HasChange("parameters")) {
+	// the parameters of the group are applied first, so that the parameters of the instance override them
+	groupChanged := false
+	if groupId, ok := d.GetOk("parameter_group_id"); ok && (!isUpdate || d.HasChange("parameter_group_id")) {
+		groupParameters, err := readRedisParameterGroupParameters(d, meta, groupId.(string))
+		if err != nil {
+			return &req, fmt.Errorf("error on reading parameter group %v: %s", groupId, err)
+		}
+		for k, v := range groupParameters {
+			parameters[k] = v
+		}
+		groupChanged = true
+	}
+	if !isUpdate || (isUpdate && d.HasChange("parameters")) || groupChanged {
 		if data, ok := d.GetOk("parameters"); ok {
 			for k, v := range data.(map[string]interface{}) {
 				parameters[k] = v.(string)
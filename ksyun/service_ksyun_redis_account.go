@@ -0,0 +1,112 @@
+package ksyun
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+// redisAccountCall calls the account api of the redis instance and waits until the instance is available again.
+func redisAccountCall(d *schema.ResourceData, meta interface{}, action string, req map[string]interface{}, wait bool) (*map[string]interface{}, error) {
+	conn := meta.(*KsyunClient).kcsv1conn
+	req["CacheId"] = d.Get("cache_id")
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := ksyunOpenApiCall(conn.Client, action, &req)
+	if err != nil {
+		return resp, err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	if wait {
+		err = checkRedisInstanceStatus(d, meta, d.Timeout(schema.TimeoutUpdate), d.Get("cache_id").(string))
+	}
+	return resp, err
+}
+
+func readRedisAccount(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	accountName := d.Get("account_name").(string)
+	resp, err := redisAccountCall(d, meta, "DescribeCacheAccounts", map[string]interface{}{}, false)
+	if err != nil {
+		return nil, err
+	}
+	data, err := getSdkValue("Data", *resp)
+	if err != nil {
+		return nil, err
+	}
+	accounts, _ := data.([]interface{})
+	for _, v := range accounts {
+		if item, ok := v.(map[string]interface{}); ok && item["accountName"] == accountName {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("redis account %s not found", accountName)
+}
+
+func redisAccountPrivilegeReq(d *schema.ResourceData, req map[string]interface{}) {
+	req["Privilege"] = d.Get("privilege")
+	for i, v := range d.Get("key_patterns").(*schema.Set).List() {
+		req["KeyPattern."+strconv.Itoa(i+1)] = v
+	}
+}
+
+func createRedisAccount(d *schema.ResourceData, meta interface{}) error {
+	err := checkRedisInstanceStatus(d, meta, d.Timeout(schema.TimeoutCreate), d.Get("cache_id").(string))
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{
+		"AccountName": d.Get("account_name"),
+		"Password":    d.Get("account_password"),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req["Description"] = v
+	}
+	redisAccountPrivilegeReq(d, req)
+	_, err = redisAccountCall(d, meta, "CreateCacheAccount", req, true)
+	if err != nil {
+		return err
+	}
+	d.SetId(d.Get("cache_id").(string) + ":" + d.Get("account_name").(string))
+	return nil
+}
+
+func modifyRedisAccount(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("account_password") {
+		req := map[string]interface{}{
+			"AccountName": d.Get("account_name"),
+			"Password":    d.Get("account_password"),
+		}
+		_, err := redisAccountCall(d, meta, "ResetCacheAccountPassword", req, true)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("privilege") || d.HasChange("key_patterns") || d.HasChange("description") {
+		req := map[string]interface{}{
+			"AccountName": d.Get("account_name"),
+			"Description": d.Get("description"),
+		}
+		redisAccountPrivilegeReq(d, req)
+		_, err := redisAccountCall(d, meta, "ModifyCacheAccount", req, true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeRedisAccount(d *schema.ResourceData, meta interface{}) error {
+	req := map[string]interface{}{
+		"AccountName": d.Get("account_name"),
+	}
+	_, err := redisAccountCall(d, meta, "DeleteCacheAccount", req, true)
+	if err != nil && (notFoundError(err) || strings.Contains(strings.ToLower(err.Error()), "cannot be found")) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,174 @@
+package ksyun
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func readRedisBackups(d *schema.ResourceData, meta interface{}, cacheId string) ([]interface{}, error) {
+	var (
+		resp *map[string]interface{}
+		err  error
+	)
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"CacheId": cacheId,
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	action := "DescribeSnapshots"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.DescribeSnapshots(&req)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	data, err := getSdkValue("Data", *resp)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return If2Slice(data)
+}
+
+func readRedisBackup(d *schema.ResourceData, meta interface{}, backupId string) (map[string]interface{}, error) {
+	if backupId == "" {
+		backupId = d.Id()
+	}
+	backups, err := readRedisBackups(d, meta, d.Get("cache_id").(string))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range backups {
+		if item, ok := v.(map[string]interface{}); ok && item["snapshotId"] == backupId {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("redis backup %s not found", backupId)
+}
+
+func createRedisBackup(d *schema.ResourceData, meta interface{}) error {
+	var (
+		resp *map[string]interface{}
+		err  error
+	)
+	conn := meta.(*KsyunClient).kcsv1conn
+	err = checkRedisInstanceStatus(d, meta, d.Timeout(schema.TimeoutCreate), d.Get("cache_id").(string))
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{
+		"CacheId": d.Get("cache_id"),
+	}
+	if name, ok := d.GetOk("backup_name"); ok {
+		req["Name"] = name
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	action := "CreateSnapshot"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.CreateSnapshot(&req)
+	if err != nil {
+		return err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	id, err := getSdkValue("Data.snapshotId", *resp)
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return fmt.Errorf("no snapshot id returned by %s", action)
+	}
+	d.SetId(id.(string))
+	return checkRedisBackupStatus(d, meta, d.Timeout(schema.TimeoutCreate))
+}
+
+func removeRedisBackup(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"CacheId":    d.Get("cache_id"),
+		"SnapshotId": d.Id(),
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		action := "DeleteSnapshot"
+		logger.Debug(logger.ReqFormat, action, req)
+		_, err := conn.DeleteSnapshot(&req)
+		if err == nil {
+			return nil
+		}
+		_, readErr := readRedisBackup(d, meta, "")
+		if readErr != nil {
+			if notFoundError(readErr) {
+				return nil
+			}
+			return resource.NonRetryableError(readErr)
+		}
+		return resource.RetryableError(err)
+	})
+}
+
+func checkRedisBackupStatus(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     []string{"SUCCESS"},
+		Refresh:    redisBackupStateRefreshFunc(d, meta, []string{"FAILED"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func redisBackupStateRefreshFunc(d *schema.ResourceData, meta interface{}, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := readRedisBackup(d, meta, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status := strings.ToUpper(fmt.Sprintf("%v", data["status"]))
+		for _, v := range failStates {
+			if v == status {
+				return nil, "", fmt.Errorf("redis backup status error, status:%v", status)
+			}
+		}
+		return data, status, nil
+	}
+}
+
+// restoreRedisInstanceFromBackup restores the data of a backup into the newly created instance.
+func restoreRedisInstanceFromBackup(d *schema.ResourceData, meta interface{}) error {
+	backupId, ok := d.GetOk("backup_id")
+	if !ok {
+		return nil
+	}
+	var (
+		resp *map[string]interface{}
+		err  error
+	)
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"CacheId":    d.Id(),
+		"SnapshotId": backupId,
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	action := "RestoreSnapshot"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.RestoreSnapshot(&req)
+	if err != nil {
+		return fmt.Errorf("error on restoring instance %q from backup %q, %s", d.Id(), backupId, err)
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	return checkRedisInstanceStatus(d, meta, d.Timeout(schema.TimeoutCreate), "")
+}
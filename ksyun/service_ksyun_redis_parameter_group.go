@@ -0,0 +1,183 @@
+package ksyun
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func readRedisParameterGroup(d *schema.ResourceData, meta interface{}, parameterGroupId string) (map[string]interface{}, error) {
+	var (
+		resp *map[string]interface{}
+		err  error
+	)
+	if parameterGroupId == "" {
+		parameterGroupId = d.Id()
+	}
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"ParameterGroupId": parameterGroupId,
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	action := "DescribeCacheParameterGroup"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.DescribeCacheParameterGroup(&req)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	data, err := getSdkValue("Data", *resp)
+	if err != nil {
+		return nil, err
+	}
+	item, ok := data.(map[string]interface{})
+	if !ok || len(item) == 0 {
+		return nil, fmt.Errorf("redis parameter group %s not found", parameterGroupId)
+	}
+	return item, nil
+}
+
+// readRedisParameterGroupParameters returns the parameters saved in the parameter group as name-value pairs.
+func readRedisParameterGroupParameters(d *schema.ResourceData, meta interface{}, parameterGroupId string) (map[string]string, error) {
+	item, err := readRedisParameterGroup(d, meta, parameterGroupId)
+	if err != nil {
+		return nil, err
+	}
+	return redisParameterGroupParameters(item), nil
+}
+
+func redisParameterGroupParameters(item map[string]interface{}) map[string]string {
+	parameters := make(map[string]string)
+	if list, ok := item["parameters"].([]interface{}); ok {
+		for _, v := range list {
+			param, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			parameters[fmt.Sprintf("%v", param["name"])] = fmt.Sprintf("%v", param["currentValue"])
+		}
+	}
+	return parameters
+}
+
+// redisParameterGroupParametersReq adds the parameters of the group to the request in a stable order.
+func redisParameterGroupParametersReq(d *schema.ResourceData, req map[string]interface{}) {
+	parameters := d.Get("parameters").(map[string]interface{})
+	var names []string
+	for k := range parameters {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for i, k := range names {
+		req[fmt.Sprintf("%v%v", "Parameters.ParameterName.", i+1)] = k
+		req[fmt.Sprintf("%v%v", "Parameters.ParameterValue.", i+1)] = parameters[k]
+	}
+}
+
+func createRedisParameterGroup(d *schema.ResourceData, meta interface{}) error {
+	var (
+		resp *map[string]interface{}
+		err  error
+	)
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"Name":          d.Get("name"),
+		"Engine":        "redis",
+		"EngineVersion": d.Get("protocol"),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req["Description"] = v
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	redisParameterGroupParametersReq(d, req)
+	action := "CreateCacheParameterGroup"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.CreateCacheParameterGroup(&req)
+	if err != nil {
+		return err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	id, err := getSdkValue("Data.id", *resp)
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return fmt.Errorf("no parameter group id returned by %s", action)
+	}
+	d.SetId(id.(string))
+	return nil
+}
+
+func modifyRedisParameterGroup(d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("name") && !d.HasChange("description") && !d.HasChange("parameters") {
+		return nil
+	}
+	var (
+		resp *map[string]interface{}
+		err  error
+	)
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"ParameterGroupId": d.Id(),
+		"Name":             d.Get("name"),
+		"Description":      d.Get("description"),
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	redisParameterGroupParametersReq(d, req)
+	action := "ModifyCacheParameterGroup"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.ModifyCacheParameterGroup(&req)
+	if err != nil {
+		return err
+	}
+	logger.Debug(logger.RespFormat, action, req, *resp)
+	return nil
+}
+
+func removeRedisParameterGroup(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*KsyunClient).kcsv1conn
+	req := map[string]interface{}{
+		"ParameterGroupId.1": d.Id(),
+	}
+	if az, ok := d.GetOk("available_zone"); ok {
+		req["AvailableZone"] = az
+	}
+	action := "DeleteCacheParameterGroup"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err := conn.DeleteCacheParameterGroup(&req)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+// redisInstanceParameterGroupDrifted reports whether a parameter of the group, which is not overridden by
+// the parameters of the instance, differs from the current value on the instance.
+func redisInstanceParameterGroupDrifted(d *schema.ResourceData, meta interface{}, current map[string]interface{}) (bool, error) {
+	groupId, ok := d.GetOk("parameter_group_id")
+	if !ok {
+		return false, nil
+	}
+	groupParameters, err := readRedisParameterGroupParameters(d, meta, groupId.(string))
+	if err != nil {
+		return false, err
+	}
+	localParams := d.Get("parameters").(map[string]interface{})
+	for k, v := range groupParameters {
+		if _, ok := localParams[k]; ok {
+			continue
+		}
+		if cv, ok := current[k]; ok && cv != v {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,530 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type SqlServerService struct {
+	client *KsyunClient
+}
+
+func (s *SqlServerService) ReadSqlServers(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.sqlserverconn
+	action := "DescribeDBInstances"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.DescribeDBInstances(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("Data.Instances", *resp)
+	if err != nil {
+		return data, err
+	}
+	return If2Slice(results)
+}
+
+func (s *SqlServerService) ReadSqlServer(d *schema.ResourceData, instanceId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if instanceId == "" {
+		instanceId = d.Id()
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+	}
+	results, err = s.ReadSqlServers(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("SQL Server instance %s not exist ", instanceId)
+	}
+	return data, err
+}
+
+// ReadAndSetSqlServer reads the primary instance when isRR is false, otherwise reads the read replica.
+func (s *SqlServerService) ReadAndSetSqlServer(d *schema.ResourceData, r *schema.Resource, isRR bool) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadSqlServer(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading sqlserver instance %q, %s", d.Id(), callErr))
+			}
+		}
+		dbInstanceType := data["DBInstanceType"]
+		if dbInstanceType == "RR" && !isRR {
+			return resource.NonRetryableError(fmt.Errorf("sqlserver instance is read replica, please use ksyun_sqlserver_rr "))
+		}
+		if dbInstanceType != "RR" && isRR {
+			return resource.NonRetryableError(fmt.Errorf("sqlserver instance is not read replica, please use ksyun_sqlserver "))
+		}
+		extra := map[string]SdkResponseMapping{
+			"DBInstanceClass": {
+				Field: "db_instance_class",
+				FieldRespFunc: func(i interface{}) interface{} {
+					if value, ok := i.(map[string]interface{}); ok {
+						return fmt.Sprintf("db.ram.%v|db.disk.%v", value["Ram"], value["Disk"])
+					}
+					return i
+				},
+			},
+			"DBInstanceName": {
+				Field: "db_instance_name",
+			},
+			"DBInstanceType": {
+				Field: "db_instance_type",
+			},
+			"DBInstanceStatus": {
+				Field: "db_instance_status",
+			},
+		}
+		if isRR {
+			extra["DBSource"] = SdkResponseMapping{
+				Field: "db_instance_identifier",
+				FieldRespFunc: func(i interface{}) interface{} {
+					if m, ok := i.(map[string]interface{}); ok {
+						return m["DBInstanceIdentifier"]
+					}
+					return nil
+				},
+			}
+			extra["AvailabilityZone"] = SdkResponseMapping{
+				Field: "availability_zone_1",
+			}
+			delete(data, "DBInstanceIdentifier")
+		} else {
+			extra["DBInstanceIdentifier"] = SdkResponseMapping{
+				Field: "db_instance_identifier",
+			}
+			extra["MasterAvailabilityZone"] = SdkResponseMapping{
+				Field: "availability_zone_1",
+			}
+			extra["SlaveAvailabilityZone"] = SdkResponseMapping{
+				Field: "availability_zone_2",
+			}
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+
+		callErr = s.ReadAndSetSqlServerParameters(d)
+		if callErr != nil {
+			return resource.NonRetryableError(callErr)
+		}
+		return nil
+	})
+}
+
+func (s *SqlServerService) ReadSqlServerParameters(instanceId string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+	}
+	conn := s.client.sqlserverconn
+	action := "DescribeDBInstanceParameters"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(conn.Client, action, &req)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("Data.Parameters", *resp)
+	if err != nil {
+		return data, err
+	}
+	return If2Map(results)
+}
+
+// ReadAndSetSqlServerParameters only sets the parameters in the configuration, as the krds instance does.
+func (s *SqlServerService) ReadAndSetSqlServerParameters(d *schema.ResourceData) (err error) {
+	local, ok := d.GetOk("parameters")
+	if !ok {
+		return err
+	}
+	remote, err := s.ReadSqlServerParameters(d.Id())
+	if err != nil {
+		return err
+	}
+	var parameters []map[string]interface{}
+	for _, value := range local.(*schema.Set).List() {
+		name := value.(map[string]interface{})["name"].(string)
+		if v, ok := remote[name]; ok {
+			if vf, ok := v.(float64); ok {
+				v = strconv.FormatFloat(vf, 'f', -1, 64)
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":  name,
+				"value": fmt.Sprintf("%v", v),
+			})
+		}
+	}
+	return d.Set("parameters", parameters)
+}
+
+func (s *SqlServerService) CreateSqlServer(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createSqlServerCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	parametersCall, err := s.modifySqlServerParametersCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(parametersCall)
+
+	return apiProcess.Run()
+}
+
+func (s *SqlServerService) createSqlServerCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {Ignore: true},
+		"region":                 {Ignore: true},
+		"db_instance_class":      {mapping: "DBInstanceClass"},
+		"db_instance_name":       {mapping: "DBInstanceName"},
+		"db_instance_type":       {mapping: "DBInstanceType"},
+		"parameters":             {Ignore: true},
+		"sub_order_id":           {Ignore: true},
+		"instance_create_time":   {Ignore: true},
+		"availability_zone_1":    {mapping: "AvailabilityZone.1"},
+		"availability_zone_2":    {mapping: "AvailabilityZone.2"},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateDBInstance",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CreateDBInstance(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("Data.Instances.0.DBInstanceIdentifier", *resp)
+			if err != nil {
+				return err
+			}
+			if id == nil {
+				return fmt.Errorf("no sqlserver instance returned by %s", call.action)
+			}
+			d.SetId(id.(string))
+			return s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) ModifySqlServer(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	commonCall, err := s.modifySqlServerCommonCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(commonCall)
+
+	specCall, err := s.modifySqlServerSpecCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(specCall)
+
+	parametersCall, err := s.modifySqlServerParametersCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(parametersCall)
+
+	return apiProcess.Run()
+}
+
+func (s *SqlServerService) modifySqlServerCommonCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_name":      {mapping: "DBInstanceName"},
+		"master_user_password":  {},
+		"preferred_backup_time": {},
+		"security_group_id":     {},
+	}
+	// read replica has no master account and backup settings
+	for k := range transform {
+		if _, ok := r.Schema[k]; !ok {
+			delete(transform, k)
+		}
+	}
+	params, err := SdkRequestAutoMapping(d, r, true, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+	if len(params) == 0 {
+		return callback, err
+	}
+	params["DBInstanceIdentifier"] = d.Id()
+
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyDBInstance",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.ModifyDBInstance(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutUpdate))
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) modifySqlServerSpecCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("db_instance_class") {
+		return callback, err
+	}
+	params := map[string]interface{}{
+		"DBInstanceIdentifier": d.Id(),
+		"DBInstanceClass":      d.Get("db_instance_class"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyDBInstanceSpec",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutUpdate))
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) modifySqlServerParametersCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("parameters") {
+		return callback, err
+	}
+	params := map[string]interface{}{}
+	index := 0
+	for _, v := range d.Get("parameters").(*schema.Set).List() {
+		parameter := v.(map[string]interface{})
+		index++
+		params["Parameters.Name."+strconv.Itoa(index)] = parameter["name"]
+		params["Parameters.Value."+strconv.Itoa(index)] = parameter["value"]
+	}
+	if index == 0 {
+		return callback, err
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "ModifyDBInstanceParameters",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return resp, err
+			}
+			// the instance id is unknown until the create call returns
+			(*call.param)["DBInstanceIdentifier"] = d.Id()
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutUpdate))
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) CreateSqlServerRr(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createSqlServerRrCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	parametersCall, err := s.modifySqlServerParametersCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(parametersCall)
+
+	return apiProcess.Run()
+}
+
+func (s *SqlServerService) createSqlServerRrCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {mapping: "DBInstanceIdentifier"},
+		"region":                 {Ignore: true},
+		"db_instance_class":      {mapping: "DBInstanceClass"},
+		"db_instance_name":       {mapping: "DBInstanceName"},
+		"availability_zone_1":    {mapping: "AvailabilityZone"},
+		"parameters":             {Ignore: true},
+		"sub_order_id":           {Ignore: true},
+		"instance_create_time":   {Ignore: true},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateDBInstanceReadReplica",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = s.checkSqlServerState(d, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("Data.DBInstance.DBInstanceIdentifier", *resp)
+			if err != nil {
+				return err
+			}
+			if id == nil {
+				return fmt.Errorf("no sqlserver read replica returned by %s", call.action)
+			}
+			d.SetId(id.(string))
+			return s.checkSqlServerState(d, "", d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) RemoveSqlServer(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeSqlServerCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *SqlServerService) removeSqlServerCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DBInstanceIdentifier": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteDBInstance",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.DeleteDBInstance(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				data, callErr := s.ReadSqlServer(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading sqlserver instance when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				if data["DBInstanceStatus"] == tDeletedStatus {
+					return nil
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) checkSqlServerState(d *schema.ResourceData, instanceId string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     []string{tActiveStatus},
+		Refresh:    s.sqlServerStateRefreshFunc(d, instanceId, []string{tFailedStatus, "ERROR"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *SqlServerService) sqlServerStateRefreshFunc(d *schema.ResourceData, instanceId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadSqlServer(d, instanceId)
+		if err != nil {
+			return nil, "", err
+		}
+		status, err := getSdkValue("DBInstanceStatus", data)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, v := range failStates {
+			if strings.EqualFold(v, status.(string)) {
+				return nil, "", fmt.Errorf("sqlserver instance status error, status:%v", status)
+			}
+		}
+		return data, status.(string), nil
+	}
+}
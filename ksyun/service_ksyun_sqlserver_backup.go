@@ -0,0 +1,217 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *SqlServerService) ReadSqlServerBackups(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	return pageQuery(condition, "MaxRecords", "Marker", 100, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		conn := s.client.sqlserverconn
+		action := "DescribeDBBackups"
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = ksyunOpenApiCall(conn.Client, action, &condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("Data.DBBackup", *resp)
+		if err != nil {
+			return data, err
+		}
+		return If2Slice(results)
+	})
+}
+
+func (s *SqlServerService) ReadSqlServerBackup(d *schema.ResourceData, backupId string) (data map[string]interface{}, err error) {
+	var (
+		results []interface{}
+	)
+	if backupId == "" {
+		backupId = d.Id()
+	}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"DBBackupIdentifier":   backupId,
+	}
+	results, err = s.ReadSqlServerBackups(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if m, ok := v.(map[string]interface{}); ok && m["DBBackupIdentifier"] == backupId {
+			data = m
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("SQL Server backup %s not exist ", backupId)
+	}
+	return data, err
+}
+
+func (s *SqlServerService) ReadAndSetSqlServerBackup(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.ReadSqlServerBackup(d, "")
+		if callErr != nil {
+			if !d.IsNewResource() {
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			} else {
+				return resource.NonRetryableError(fmt.Errorf("error on reading sqlserver backup %q, %s", d.Id(), callErr))
+			}
+		}
+		SdkResponseAutoResourceData(d, r, data, krdsBackupResponseMapping())
+		return nil
+	})
+}
+
+func (s *SqlServerService) CreateSqlServerBackup(d *schema.ResourceData, r *schema.Resource) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	createCall, err := s.createSqlServerBackupCall(d, r)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(createCall)
+
+	return apiProcess.Run()
+}
+
+func (s *SqlServerService) RemoveSqlServerBackup(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, true)
+
+	removeCall, err := s.removeSqlServerBackupCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(removeCall)
+
+	return apiProcess.Run()
+}
+
+func (s *SqlServerService) createSqlServerBackupCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"db_instance_identifier": {
+			mapping: "DBInstanceIdentifier",
+		},
+		"backup_name": {
+			mapping: "DBBackupName",
+		},
+		"description": {
+			mapping: "Description",
+		},
+	}
+	params, err := SdkRequestAutoMapping(d, r, false, transform, nil)
+	if err != nil {
+		return callback, err
+	}
+
+	callback = ApiCall{
+		param:  &params,
+		action: "CreateDBBackup",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			err = s.checkSqlServerState(d, d.Get("db_instance_identifier").(string), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return resp, err
+			}
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("Data.DBBackup.DBBackupIdentifier", *resp)
+			if err != nil {
+				return err
+			}
+			if id == nil {
+				return fmt.Errorf("no sqlserver backup returned by %s", call.action)
+			}
+			d.SetId(id.(string))
+			return s.checkSqlServerBackupState(d, []string{"COMPLETED"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) removeSqlServerBackupCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"DBBackupIdentifier":   d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteDBBackup",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.sqlserverconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(conn.Client, call.action, call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadSqlServerBackup(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on reading sqlserver backup when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *SqlServerService) checkSqlServerBackupState(d *schema.ResourceData, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     target,
+		Refresh:    s.sqlServerBackupStateRefreshFunc(d, []string{"FAILED"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *SqlServerService) sqlServerBackupStateRefreshFunc(d *schema.ResourceData, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadSqlServerBackup(d, "")
+		if err != nil {
+			return nil, "", err
+		}
+		status, err := getSdkValue("BackupStatus", data)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, v := range failStates {
+			if v == status.(string) {
+				return nil, "", fmt.Errorf("sqlserver backup status error, status:%v", status)
+			}
+		}
+		return data, status.(string), nil
+	}
+}
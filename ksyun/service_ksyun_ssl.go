@@ -0,0 +1,336 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+const (
+	sslOrderStatusIssued   = "Issued"
+	sslOrderStatusFailed   = "Failed"
+	sslOrderStatusCanceled = "Canceled"
+)
+
+type SslService struct {
+	client *KsyunClient
+}
+
+// sslApiCall returns an ApiCall which calls the ssl certificate open api, the sdk doesn't provide the ssl client.
+func sslApiCall(action string, params map[string]interface{}) ApiCall {
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			resp, err = ksyunOpenApiCall(client.sslconn, call.action, call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+// start ssl certificate order
+
+func (s *SslService) ReadSslCertificateOrder(orderId string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	req := map[string]interface{}{
+		"OrderId.1": orderId,
+	}
+	action := "DescribeCertificateOrders"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = ksyunOpenApiCall(s.client.sslconn, action, &req)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("OrderSet", *resp)
+	if err != nil {
+		return data, err
+	}
+	orders, _ := results.([]interface{})
+	for _, v := range orders {
+		data, _ = v.(map[string]interface{})
+	}
+	// the canceled order can't be issued anymore, so it's treated as deleted
+	if len(data) == 0 || data["Status"] == sslOrderStatusCanceled {
+		return data, fmt.Errorf("ssl certificate order %s not exist ", orderId)
+	}
+	return data, err
+}
+
+func (s *SslService) ReadAndSetSslCertificateOrder(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadSslCertificateOrder(d.Id())
+	if err != nil {
+		return err
+	}
+	extra := map[string]SdkResponseMapping{
+		"SubjectAlternativeNameSet": {
+			Field: "subject_alternative_names",
+		},
+		"ValidationRecordSet": {
+			Field: "validation_records",
+		},
+	}
+	SdkResponseAutoResourceData(d, r, data, extra)
+	return err
+}
+
+func (s *SslService) CreateSslCertificateOrder(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"subject_alternative_names": {
+			mapping: "SubjectAlternativeName",
+			Type:    TransformWithN,
+		},
+	}
+	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	req["ValidationMethod"] = "DNS"
+
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	createCall := sslApiCall("CreateCertificateOrder", req)
+	createCall.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		id, err := getSdkValue("OrderId", *resp)
+		if err != nil {
+			return err
+		}
+		if id == nil {
+			return fmt.Errorf("no order id returned by %s", call.action)
+		}
+		d.SetId(fmt.Sprintf("%v", id))
+		return s.checkSslValidationRecords(d, d.Timeout(schema.TimeoutCreate))
+	}
+	apiProcess.PutCalls(createCall)
+	return apiProcess.Run()
+}
+
+// checkSslValidationRecords waits for the validation records, which are generated after the order is submitted to the CA.
+func (s *SslService) checkSslValidationRecords(d *schema.ResourceData, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			data, err := s.ReadSslCertificateOrder(d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if data["Status"] == sslOrderStatusFailed {
+				return nil, "", fmt.Errorf("ssl certificate order failed, %v", data["StatusReason"])
+			}
+			records, _ := data["ValidationRecordSet"].([]interface{})
+			if len(records) > 0 || data["Status"] == sslOrderStatusIssued {
+				return data, "ready", nil
+			}
+			return data, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// RemoveSslCertificateOrder cancels the order which has not been issued, the issued certificate is kept valid until it expires.
+func (s *SslService) RemoveSslCertificateOrder(d *schema.ResourceData) (err error) {
+	data, err := s.ReadSslCertificateOrder(d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if data["Status"] == sslOrderStatusIssued {
+		return err
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	call := sslApiCall("CancelCertificateOrder", map[string]interface{}{
+		"OrderId": d.Id(),
+	})
+	call.callError = func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+		if notFoundError(baseErr) {
+			return nil
+		}
+		return baseErr
+	}
+	apiProcess.PutCalls(call)
+	return apiProcess.Run()
+}
+
+// start ssl certificate order validation
+
+// checkSslValidationRecordFqdns checks that every validation record of the order is in the fqdns,
+// so the validation doesn't wait for the records which are never created.
+func checkSslValidationRecordFqdns(records []interface{}, fqdns []string) error {
+	created := map[string]bool{}
+	for _, fqdn := range fqdns {
+		created[strings.ToLower(strings.TrimSuffix(fqdn, "."))] = true
+	}
+	var missing []string
+	for _, v := range records {
+		record, _ := v.(map[string]interface{})
+		name := strings.ToLower(strings.TrimSuffix(fmt.Sprintf("%v", record["RecordName"]), "."))
+		if !created[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("the validation records %s are not in validation_record_fqdns", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (s *SslService) checkSslCertificateIssued(d *schema.ResourceData, orderId string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{sslOrderStatusIssued},
+		Refresh: func() (interface{}, string, error) {
+			data, err := s.ReadSslCertificateOrder(orderId)
+			if err != nil {
+				return nil, "", err
+			}
+			status := fmt.Sprintf("%v", data["Status"])
+			if status == sslOrderStatusFailed {
+				return nil, "", fmt.Errorf("ssl certificate order failed, %v", data["StatusReason"])
+			}
+			return data, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// CreateSslCertificateOrderValidation waits for the certificate to be issued, then uploads it to KCM.
+// The private key is passed to KCM directly and it's never saved into the state.
+func (s *SslService) CreateSslCertificateOrderValidation(d *schema.ResourceData) (err error) {
+	orderId := d.Get("order_id").(string)
+	if fqdns, ok := d.GetOk("validation_record_fqdns"); ok {
+		data, err := s.ReadSslCertificateOrder(orderId)
+		if err != nil {
+			return err
+		}
+		records, _ := data["ValidationRecordSet"].([]interface{})
+		if err = checkSslValidationRecordFqdns(records, SchemaSetToStringSlice(fqdns)); err != nil {
+			return err
+		}
+	}
+	if err = s.checkSslCertificateIssued(d, orderId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	downloadCall := sslApiCall("DownloadCertificate", map[string]interface{}{
+		"OrderId": orderId,
+	})
+	downloadCall.afterCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), "******")
+		publicKey, err := getSdkValue("PublicKey", *resp)
+		if err != nil {
+			return err
+		}
+		privateKey, err := getSdkValue("PrivateKey", *resp)
+		if err != nil {
+			return err
+		}
+		if publicKey == nil || privateKey == nil {
+			return fmt.Errorf("no certificate returned by %s", call.action)
+		}
+		name := d.Get("certificate_name").(string)
+		if name == "" {
+			name = orderId
+		}
+		return s.uploadSslCertificate(d, name, fmt.Sprintf("%v", publicKey), fmt.Sprintf("%v", privateKey))
+	}
+	apiProcess.PutCalls(downloadCall)
+	return apiProcess.Run()
+}
+
+func (s *SslService) uploadSslCertificate(d *schema.ResourceData, name string, publicKey string, privateKey string) (err error) {
+	req := map[string]interface{}{
+		"CertificateName": name,
+		"PublicKey":       strings.Replace(publicKey, "\n", "\\n", -1),
+		"PrivateKey":      strings.Replace(privateKey, "\n", "\\n", -1),
+	}
+	action := "CreateCertificate"
+	logger.Debug(logger.ReqFormat, action, map[string]interface{}{"CertificateName": name})
+	resp, err := s.client.kcmconn.CreateCertificate(&req)
+	if err != nil {
+		return err
+	}
+	id, err := getSdkValue("Certificate.CertificateId", *resp)
+	if err != nil {
+		return err
+	}
+	d.SetId(d.Get("order_id").(string))
+	if err = d.Set("certificate_name", name); err != nil {
+		return err
+	}
+	return d.Set("certificate_id", id)
+}
+
+func (s *SslService) ReadAndSetSslCertificateOrderValidation(d *schema.ResourceData) (err error) {
+	if _, err = s.ReadSslCertificateOrder(d.Id()); err != nil {
+		return err
+	}
+	kcmService := KcmService{s.client}
+	data, err := kcmService.ReadCertificate(d, d.Get("certificate_id").(string))
+	if err != nil {
+		return err
+	}
+	if err = d.Set("certificate_name", data["CertificateName"]); err != nil {
+		return err
+	}
+	if info, parseErr := parseCertificatePem(fmt.Sprintf("%v", data["PublicKey"])); parseErr == nil {
+		if err = d.Set("not_after", info.NotAfter.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// RemoveSslCertificateOrderValidation deletes the certificate from KCM, the order is kept.
+func (s *SslService) RemoveSslCertificateOrderValidation(d *schema.ResourceData) (err error) {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+	params := map[string]interface{}{
+		"CertificateId": d.Get("certificate_id"),
+	}
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "DeleteCertificate",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.ReqFormat, call.action, *(call.param))
+			return client.kcmconn.DeleteCertificate(call.param)
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			if notFoundError(baseErr) {
+				return nil
+			}
+			return baseErr
+		},
+	})
+	return apiProcess.Run()
+}
@@ -0,0 +1,19 @@
+package ksyun
+
+import (
+	"testing"
+)
+
+func TestCheckSslValidationRecordFqdns(t *testing.T) {
+	records := []interface{}{
+		map[string]interface{}{"Domain": "www.example.com", "RecordName": "_dnsauth.www.example.com"},
+		map[string]interface{}{"Domain": "api.example.com", "RecordName": "_dnsauth.api.example.com."},
+	}
+	if err := checkSslValidationRecordFqdns(records, []string{"_dnsauth.WWW.example.com.", "_dnsauth.api.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	err := checkSslValidationRecordFqdns(records, []string{"_dnsauth.www.example.com"})
+	if err == nil || err.Error() != "the validation records _dnsauth.api.example.com are not in validation_record_fqdns" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
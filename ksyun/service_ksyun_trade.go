@@ -0,0 +1,154 @@
+package ksyun
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type TradeService struct {
+	client *KsyunClient
+}
+
+const (
+	hoursPerDay   = 24
+	daysPerMonth  = 30
+	hoursPerMonth = hoursPerDay * daysPerMonth
+)
+
+func (s *TradeService) ReadProductPrice(condition map[string]interface{}) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+		ok      bool
+	)
+	action := "QueryProductPrice"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = ksyunOpenApiCall(s.client.tradeconn, action, &condition)
+	if err != nil {
+		return data, err
+	}
+	logger.Debug(logger.RespFormat, action, condition, *resp)
+	results, err = getSdkValue("Price", *resp)
+	if err != nil {
+		return data, err
+	}
+	if data, ok = results.(map[string]interface{}); !ok {
+		return data, fmt.Errorf("price of %s not exist ", condition["ProductType"])
+	}
+	return data, err
+}
+
+func (s *TradeService) ReadAndSetPriceEstimate(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"system_disk": {
+			Type: TransformListUnique,
+		},
+		"data_disks": {
+			mappings: map[string]string{
+				"data_disks": "DataDisk",
+				"disk_size":  "Size",
+				"disk_type":  "Type",
+			}, Type: TransformListN,
+		},
+	}
+	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return err
+	}
+	delete(req, "OutputFile")
+
+	data, err := s.ReadProductPrice(req)
+	if err != nil {
+		return err
+	}
+
+	price, err := priceEstimateValue(data, "DiscountPrice")
+	if err != nil {
+		price, err = priceEstimateValue(data, "OriginalPrice")
+		if err != nil {
+			return err
+		}
+	}
+	unit, _ := data["Unit"].(string)
+	hourly, monthly, err := priceEstimateHourlyAndMonthly(price, unit, d.Get("purchase_time").(int))
+	if err != nil {
+		return err
+	}
+
+	extra := map[string]SdkResponseMapping{
+		"Unit": {
+			Field: "price_unit",
+		},
+	}
+	SdkResponseAutoResourceData(d, r, data, extra)
+	err = d.Set("hourly_price", hourly)
+	if err != nil {
+		return err
+	}
+	err = d.Set("monthly_price", monthly)
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	for k, v := range req {
+		keys = append(keys, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(keys)
+	d.SetId(hashStringArray(keys))
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		return writeToFile(outputFile.(string), map[string]interface{}{
+			"original_price": d.Get("original_price"),
+			"discount_price": d.Get("discount_price"),
+			"price_unit":     unit,
+			"hourly_price":   hourly,
+			"monthly_price":  monthly,
+		})
+	}
+	return err
+}
+
+func priceEstimateValue(data map[string]interface{}, key string) (float64, error) {
+	switch v := data[key].(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, fmt.Errorf("%s of price not exist ", key)
+}
+
+// priceEstimateHourlyAndMonthly converts the price of the charge unit to the hourly and monthly price.
+// A month is counted as 30 days, the price of a prepaid order is divided by the purchase time.
+func priceEstimateHourlyAndMonthly(price float64, unit string, purchaseTime int) (hourly float64, monthly float64, err error) {
+	switch strings.ToLower(unit) {
+	case "hour":
+		hourly = price
+		monthly = price * hoursPerMonth
+	case "day":
+		hourly = price / hoursPerDay
+		monthly = price * daysPerMonth
+	case "month":
+		if purchaseTime < 1 {
+			purchaseTime = 1
+		}
+		monthly = price / float64(purchaseTime)
+		hourly = monthly / hoursPerMonth
+	default:
+		return hourly, monthly, fmt.Errorf("price unit %q is not supported", unit)
+	}
+	return priceEstimateRound(hourly), priceEstimateRound(monthly), err
+}
+
+func priceEstimateRound(price float64) float64 {
+	return math.Round(price*10000) / 10000
+}
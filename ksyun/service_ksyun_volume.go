@@ -183,6 +183,10 @@ func (s *EbsService) CreateVolumeCall(d *schema.ResourceData, r *schema.Resource
 	if err != nil {
 		return callback, err
 	}
+	// the volume with a customer key must be encrypted
+	if _, ok := req["KmsKeyId"]; ok {
+		req["Encrypted"] = true
+	}
 	callback = ApiCall{
 		param:  &req,
 		action: "CreateVolume",
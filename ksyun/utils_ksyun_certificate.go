@@ -0,0 +1,100 @@
+package ksyun
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+type certificateInfo struct {
+	NotBefore               time.Time
+	NotAfter                time.Time
+	SubjectCommonName       string
+	SubjectAlternativeNames []string
+	IssuerCommonName        string
+	SerialNumber            string
+	FingerprintSha256       string
+}
+
+// parseCertificatePem parses the first certificate of the chain, which is the certificate of the server.
+// The escaped line breaks are accepted as well, since the certificates are often written in one line.
+func parseCertificatePem(content string) (*certificateInfo, error) {
+	content = strings.Replace(content, `\n`, "\n", -1)
+	rest := []byte(content)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no certificate is found in the PEM")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate is not valid: %s", err)
+		}
+		fingerprint := sha256.Sum256(cert.Raw)
+		info := &certificateInfo{
+			NotBefore:         cert.NotBefore.UTC(),
+			NotAfter:          cert.NotAfter.UTC(),
+			SubjectCommonName: cert.Subject.CommonName,
+			IssuerCommonName:  cert.Issuer.CommonName,
+			SerialNumber:      fmt.Sprintf("%x", cert.SerialNumber),
+			FingerprintSha256: hex.EncodeToString(fingerprint[:]),
+		}
+		info.SubjectAlternativeNames = append(info.SubjectAlternativeNames, cert.DNSNames...)
+		for _, ip := range cert.IPAddresses {
+			info.SubjectAlternativeNames = append(info.SubjectAlternativeNames, ip.String())
+		}
+		return info, nil
+	}
+}
+
+// daysToExpiry returns the whole days left before the certificate expires, it's negative if the certificate has expired.
+func (c *certificateInfo) daysToExpiry(now time.Time) int {
+	return int(math.Floor(c.NotAfter.Sub(now).Hours() / 24))
+}
+
+// matchDomain checks whether the certificate serves the domain, the wildcard matches one label only.
+func (c *certificateInfo) matchDomain(domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	names := append([]string{c.SubjectCommonName}, c.SubjectAlternativeNames...)
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if name == domain {
+			return true
+		}
+		if strings.HasPrefix(name, "*.") {
+			if i := strings.Index(domain, "."); i > 0 && domain[i+1:] == name[2:] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// certificateInfoFields returns the computed fields of the certificate which are parsed from the PEM.
+func certificateInfoFields(c *certificateInfo) map[string]interface{} {
+	return map[string]interface{}{
+		"not_before":                c.NotBefore.Format(time.RFC3339),
+		"not_after":                 c.NotAfter.Format(time.RFC3339),
+		"subject_common_name":       c.SubjectCommonName,
+		"subject_alternative_names": c.SubjectAlternativeNames,
+		"issuer_common_name":        c.IssuerCommonName,
+		"serial_number":             c.SerialNumber,
+		"fingerprint_sha256":        c.FingerprintSha256,
+	}
+}
+
+func validateCertificatePem(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parseCertificatePem(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %s", k, err))
+	}
+	return ws, errs
+}
@@ -0,0 +1,123 @@
+package ksyun
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// testGenerateCertificate generates a self-signed certificate and its private key in PEM.
+func testGenerateCertificate(t *testing.T, commonName string, dnsNames []string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPem), string(keyPem)
+}
+
+func TestParseCertificatePem(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certPem, keyPem := testGenerateCertificate(t, "www.example.com", []string{"www.example.com", "*.api.example.com"}, notAfter)
+
+	// the private key before the certificate and the escaped line breaks are accepted
+	info, err := parseCertificatePem(strings.Replace(keyPem+certPem, "\n", `\n`, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.NotAfter.Equal(notAfter) || info.SubjectCommonName != "www.example.com" || len(info.SubjectAlternativeNames) != 2 {
+		t.Fatalf("unexpected certificate %+v", info)
+	}
+	if len(info.FingerprintSha256) != 64 {
+		t.Fatalf("unexpected fingerprint %s", info.FingerprintSha256)
+	}
+	if days := info.daysToExpiry(notAfter.Add(-36 * time.Hour)); days != 1 {
+		t.Fatalf("expect 1 day to expiry, got %d", days)
+	}
+	if days := info.daysToExpiry(notAfter.Add(time.Hour)); days != -1 {
+		t.Fatalf("expect -1 day to expiry, got %d", days)
+	}
+
+	for domain, expect := range map[string]bool{
+		"www.example.com":      true,
+		"WWW.Example.com.":     true,
+		"v1.api.example.com":   true,
+		"api.example.com":      false,
+		"a.v1.api.example.com": false,
+		"example.com":          false,
+	} {
+		if info.matchDomain(domain) != expect {
+			t.Fatalf("domain %s: expect %t", domain, expect)
+		}
+	}
+
+	if _, err = parseCertificatePem(keyPem); err == nil {
+		t.Fatal("the PEM without certificate should be rejected")
+	}
+}
+
+func TestCertificateInfoPlugin(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiring, _ := testGenerateCertificate(t, "www.example.com", []string{"www.example.com"}, now.AddDate(0, 0, 10))
+	valid, _ := testGenerateCertificate(t, "*.example.com", nil, now.AddDate(1, 0, 0))
+	items := []map[string]interface{}{
+		{"CertificateId": "expiring", "PublicKey": expiring},
+		{"CertificateId": "valid", "PublicKey": valid},
+		{"CertificateId": "unknown"},
+	}
+	filter := func(raw map[string]interface{}) (ids []string) {
+		d := schema.TestResourceDataRaw(t, dataSourceKsyunCertificates().Schema, raw)
+		plugin := certificateInfoPlugin(now)
+		for _, item := range items {
+			copied := map[string]interface{}{}
+			for k, v := range item {
+				copied[k] = v
+			}
+			result, _, err := plugin(d, copied)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != nil {
+				ids = append(ids, result["CertificateId"].(string))
+			}
+		}
+		return ids
+	}
+
+	if ids := filter(map[string]interface{}{}); len(ids) != 3 {
+		t.Fatalf("expect all certificates without filters, got %v", ids)
+	}
+	if ids := filter(map[string]interface{}{"domain": "www.example.com"}); len(ids) != 2 {
+		t.Fatalf("expect the certificates of www.example.com, got %v", ids)
+	}
+	if ids := filter(map[string]interface{}{"domain": "www.example.com", "expire_within_days": 30}); len(ids) != 1 || ids[0] != "expiring" {
+		t.Fatalf("expect the expiring certificate, got %v", ids)
+	}
+}
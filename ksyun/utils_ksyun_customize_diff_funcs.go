@@ -45,6 +45,28 @@ func kecNetworkInterfaceCustomizeDiff(d *schema.ResourceDiff, meta interface{})
 	return err
 }
 
+// kecInstanceCustomizeDiff checks the fields which are required when the instance is not launched from a template.
+func kecInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err error) {
+	if d.Id() != "" {
+		return err
+	}
+	if _, ok := d.GetOk("launch_template_id"); ok || !d.NewValueKnown("launch_template_id") {
+		return err
+	}
+	if _, ok := d.GetOk("launch_template_version"); ok {
+		return fmt.Errorf("launch_template_version must be set with launch_template_id")
+	}
+	for _, k := range []string{"image_id", "subnet_id", "security_group_id", "charge_type"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+		if _, ok := d.GetOk(k); !ok {
+			return fmt.Errorf("%s is required when launch_template_id is not set", k)
+		}
+	}
+	return err
+}
+
 func networkAclEntryCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err error) {
 	if d.HasChange("network_acl_entries") {
 		m := make(map[string]interface{})
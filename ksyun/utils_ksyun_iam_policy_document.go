@@ -0,0 +1,274 @@
+package ksyun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const iamPolicyDocumentVersion = "2015-11-01"
+
+var (
+	iamPolicyActionRegexp = regexp.MustCompile(`^(\*|[a-z0-9-]+:[A-Za-z0-9*?]+)$`)
+	// krn:ksc:${service}:${region}:${account}:${resource}
+	iamPolicyKrnRegexp = regexp.MustCompile(`^krn:ksc:[a-z0-9*-]+:[a-z0-9*-]*:[A-Za-z0-9*-]*:.+$`)
+
+	iamPolicyConditionOperators = []string{
+		"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase",
+		"StringLike", "StringNotLike",
+		"NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals",
+		"NumericGreaterThan", "NumericGreaterThanEquals",
+		"DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals",
+		"DateGreaterThan", "DateGreaterThanEquals",
+		"Bool", "IpAddress", "NotIpAddress", "Null",
+	}
+)
+
+// iamPolicyStringList accepts both a single string and an array of strings, which are equal in a policy document.
+type iamPolicyStringList []string
+
+func (l *iamPolicyStringList) UnmarshalJSON(b []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch v := raw.(type) {
+	case []interface{}:
+		for _, item := range v {
+			*l = append(*l, fmt.Sprintf("%v", item))
+		}
+	case nil:
+	default:
+		*l = iamPolicyStringList{fmt.Sprintf("%v", v)}
+	}
+	return nil
+}
+
+type iamPolicyStatement struct {
+	Sid         string                                    `json:"Sid,omitempty"`
+	Effect      string                                    `json:"Effect"`
+	Principal   map[string]iamPolicyStringList            `json:"Principal,omitempty"`
+	Action      iamPolicyStringList                       `json:"Action,omitempty"`
+	NotAction   iamPolicyStringList                       `json:"NotAction,omitempty"`
+	Resource    iamPolicyStringList                       `json:"Resource,omitempty"`
+	NotResource iamPolicyStringList                       `json:"NotResource,omitempty"`
+	Condition   map[string]map[string]iamPolicyStringList `json:"Condition,omitempty"`
+}
+
+type iamPolicyDocument struct {
+	Version   string                `json:"Version,omitempty"`
+	Statement []*iamPolicyStatement `json:"Statement"`
+}
+
+// parseIamPolicyDocument rejects the unknown keys instead of dropping them silently.
+func parseIamPolicyDocument(document string) (doc *iamPolicyDocument, err error) {
+	doc = &iamPolicyDocument{}
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(doc); err != nil {
+		return nil, fmt.Errorf("policy document is not valid: %s", err)
+	}
+	return doc, nil
+}
+
+func sortedUniqueStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	set := map[string]bool{}
+	var result []string
+	for _, v := range values {
+		if !set[v] {
+			set[v] = true
+			result = append(result, v)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// canonicalize sorts and deduplicates every list, so the documents which only differ in order render the same.
+func (doc *iamPolicyDocument) canonicalize() {
+	for _, st := range doc.Statement {
+		st.Action = sortedUniqueStrings(st.Action)
+		st.NotAction = sortedUniqueStrings(st.NotAction)
+		st.Resource = sortedUniqueStrings(st.Resource)
+		st.NotResource = sortedUniqueStrings(st.NotResource)
+		for k, v := range st.Principal {
+			st.Principal[k] = sortedUniqueStrings(v)
+		}
+		for _, variables := range st.Condition {
+			for k, v := range variables {
+				variables[k] = sortedUniqueStrings(v)
+			}
+		}
+	}
+}
+
+// merge adds the statements to the document, the statement replaces the one with the same sid.
+func (doc *iamPolicyDocument) merge(statements []*iamPolicyStatement) {
+	for _, st := range statements {
+		replaced := false
+		if st.Sid != "" {
+			for i, exist := range doc.Statement {
+				if exist.Sid == st.Sid {
+					doc.Statement[i] = st
+					replaced = true
+					break
+				}
+			}
+		}
+		if !replaced {
+			doc.Statement = append(doc.Statement, st)
+		}
+	}
+}
+
+func validateIamPolicyConditionOperator(operator string) bool {
+	operator = strings.TrimPrefix(operator, "ForAnyValue:")
+	operator = strings.TrimPrefix(operator, "ForAllValues:")
+	operator = strings.TrimSuffix(operator, "IfExists")
+	for _, v := range iamPolicyConditionOperators {
+		if v == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (doc *iamPolicyDocument) validate() error {
+	if len(doc.Statement) == 0 {
+		return fmt.Errorf("policy document must contain at least one statement")
+	}
+	sids := map[string]bool{}
+	for i, st := range doc.Statement {
+		name := fmt.Sprintf("statement %d", i)
+		if st.Sid != "" {
+			name = fmt.Sprintf("statement %q", st.Sid)
+			if sids[st.Sid] {
+				return fmt.Errorf("%s: sid is duplicated", name)
+			}
+			sids[st.Sid] = true
+		}
+		if st.Effect != "Allow" && st.Effect != "Deny" {
+			return fmt.Errorf("%s: effect must be Allow or Deny, got %q", name, st.Effect)
+		}
+		if len(st.Action) > 0 && len(st.NotAction) > 0 {
+			return fmt.Errorf("%s: action and not action can not be set together", name)
+		}
+		if len(st.Action) == 0 && len(st.NotAction) == 0 {
+			return fmt.Errorf("%s: at least one action is required", name)
+		}
+		for _, action := range append(append([]string{}, st.Action...), st.NotAction...) {
+			if !iamPolicyActionRegexp.MatchString(action) {
+				return fmt.Errorf("%s: action %q must be like `service:ActionName`", name, action)
+			}
+		}
+		if len(st.Resource) > 0 && len(st.NotResource) > 0 {
+			return fmt.Errorf("%s: resource and not resource can not be set together", name)
+		}
+		for _, krn := range append(append([]string{}, st.Resource...), st.NotResource...) {
+			if krn != "*" && !iamPolicyKrnRegexp.MatchString(krn) {
+				return fmt.Errorf("%s: resource %q must be `*` or like `krn:ksc:service:region:account:resource`", name, krn)
+			}
+		}
+		for operator := range st.Condition {
+			if !validateIamPolicyConditionOperator(operator) {
+				return fmt.Errorf("%s: condition operator %q is not supported", name, operator)
+			}
+		}
+	}
+	return nil
+}
+
+func (doc *iamPolicyDocument) String() (string, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	// keep the krn and condition values such as `<`, `>` and `&` as they are
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// decodeIamPolicyDocument unescapes the document returned by the api, which is url encoded sometimes.
+func decodeIamPolicyDocument(document string) (string, error) {
+	if document == "" || strings.HasPrefix(strings.TrimSpace(document), "{") {
+		return document, nil
+	}
+	return url.QueryUnescape(document)
+}
+
+func validateIamPolicyDocument(v interface{}, k string) (ws []string, errs []error) {
+	doc, err := parseIamPolicyDocument(v.(string))
+	if err != nil {
+		return ws, append(errs, fmt.Errorf("%s: %s", k, err))
+	}
+	if err = doc.validate(); err != nil {
+		return ws, append(errs, fmt.Errorf("%s: %s", k, err))
+	}
+	return ws, errs
+}
+
+// validateIamPolicyDocumentJson only rejects the document which is not JSON, the other problems are
+// reported as warnings, so that the documents accepted by the api before keep working.
+func validateIamPolicyDocumentJson(v interface{}, k string) (ws []string, errs []error) {
+	document := v.(string)
+	if document == "" {
+		return ws, errs
+	}
+	if !json.Valid([]byte(document)) {
+		return ws, append(errs, fmt.Errorf("%s: policy document is not valid JSON", k))
+	}
+	_, strictErrs := validateIamPolicyDocument(v, k)
+	for _, err := range strictErrs {
+		ws = append(ws, err.Error())
+	}
+	return ws, errs
+}
+
+// validateIamTrustPolicyDocument requires a principal in every statement besides the rules of the policy document.
+func validateIamTrustPolicyDocument(v interface{}, k string) (ws []string, errs []error) {
+	if ws, errs = validateIamPolicyDocument(v, k); len(errs) > 0 {
+		return ws, errs
+	}
+	doc, _ := parseIamPolicyDocument(v.(string))
+	for i, st := range doc.Statement {
+		if len(st.Principal) == 0 {
+			errs = append(errs, fmt.Errorf("%s: statement %d: principal is required in the trust policy", k, i))
+		}
+	}
+	return ws, errs
+}
+
+func normalizeIamPolicyDocument(document string) (string, error) {
+	doc, err := parseIamPolicyDocument(document)
+	if err != nil {
+		return "", err
+	}
+	doc.canonicalize()
+	return doc.String()
+}
+
+func iamPolicyDocumentDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	oldDoc, err := normalizeIamPolicyDocument(old)
+	if err != nil {
+		return false
+	}
+	newDoc, err := normalizeIamPolicyDocument(new)
+	if err != nil {
+		return false
+	}
+	return oldDoc == newDoc
+}
@@ -0,0 +1,118 @@
+package ksyun
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeIamPolicyDocument(t *testing.T) {
+	a, err := normalizeIamPolicyDocument(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["kec:StartInstances","kec:DescribeInstances"],"Resource":"*"}]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := normalizeIamPolicyDocument(`{
+  "Statement": [{"Resource": ["*"], "Action": ["kec:DescribeInstances", "kec:StartInstances", "kec:DescribeInstances"], "Effect": "Allow"}],
+  "Version": "2015-11-01"
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatalf("documents should be equal:\n%s\n%s", a, b)
+	}
+	if iamPolicyDocumentDiffSuppressFunc("policy_document", a, `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"kec:DescribeInstances","Resource":"*"}]}`, nil) {
+		t.Fatal("documents with different actions should not be suppressed")
+	}
+	if _, err = normalizeIamPolicyDocument(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Actions":["kec:*"]}]}`); err == nil {
+		t.Fatal("unknown keys should be rejected")
+	}
+}
+
+func TestIamPolicyDocumentMerge(t *testing.T) {
+	doc, _ := parseIamPolicyDocument(`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"kec:*"},{"Effect":"Allow","Action":"ks3:*"}]}`)
+	doc.merge([]*iamPolicyStatement{
+		{Sid: "A", Effect: "Deny", Action: iamPolicyStringList{"kec:*"}},
+		{Sid: "B", Effect: "Allow", Action: iamPolicyStringList{"iam:List*"}},
+	})
+	if len(doc.Statement) != 3 || doc.Statement[0].Effect != "Deny" || doc.Statement[2].Sid != "B" {
+		t.Fatalf("unexpected merged statements %+v", doc.Statement)
+	}
+}
+
+func TestIamPolicyDocumentValidate(t *testing.T) {
+	cases := map[string]string{
+		`{"Statement":[{"Effect":"Allow","Action":"kec:DescribeInstances","Resource":"krn:ksc:kec:cn-beijing-6:2000012345:instance/*"}]}`:          "",
+		`{"Statement":[{"Effect":"Allow","Action":"ks3:*","Resource":["krn:ksc:ks3:::bucket","krn:ksc:ks3:::bucket/*"]}]}`:                         "",
+		`{"Statement":[{"Effect":"Permit","Action":"kec:*"}]}`:                                                                                     "effect",
+		`{"Statement":[{"Effect":"Allow","Action":"DescribeInstances"}]}`:                                                                          "action",
+		`{"Statement":[{"Effect":"Allow","Action":"kec:*","Resource":"arn:aws:s3:::bucket"}]}`:                                                     "resource",
+		`{"Statement":[{"Effect":"Allow","Action":"kec:*","Resource":"*","Condition":{"IpEquals":{"ksc:SourceIp":"10.0.0.0/8"}}}]}`:                "condition",
+		`{"Statement":[{"Effect":"Allow","Action":"kec:*","Resource":"*","Condition":{"IpAddressIfExists":{"ksc:SourceIp":"10.0.0.0/8"}}}]}`:       "",
+		`{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["kec"]}}]}`:                                             "",
+		`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"kec:*","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"kec:*","Resource":"*"}]}`: "duplicated",
+		`{"Statement":[{"Effect":"Allow","NotAction":"iam:*","NotResource":"krn:ksc:kec:*:*:instance/i-1"}]}`:                                      "",
+		`{"Statement":[{"Effect":"Allow","Action":"kec:*","NotAction":"kec:Delete*","Resource":"*"}]}`:                                             "not action",
+		`{"Statement":[{"Effect":"Allow","NotAction":"DeleteInstances","Resource":"*"}]}`:                                                          "action",
+	}
+	for document, expect := range cases {
+		doc, err := parseIamPolicyDocument(document)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = doc.validate()
+		if expect == "" && err != nil {
+			t.Fatalf("%s should be valid: %s", document, err)
+		}
+		if expect != "" && (err == nil || !strings.Contains(err.Error(), expect)) {
+			t.Fatalf("%s should be invalid with %s: %v", document, expect, err)
+		}
+	}
+}
+
+func TestValidateIamPolicyDocument(t *testing.T) {
+	_, errs := validateIamPolicyDocument(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Resource":["*"]}]}`, "policy_document")
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	_, errs = validateIamPolicyDocument(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["List*"],"Resource":["*"]}]}`, "policy_document")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "policy_document") {
+		t.Fatalf("invalid action should be rejected, got %v", errs)
+	}
+	_, errs = validateIamPolicyDocument(`{"Version":"2015-11-01"`, "policy_document")
+	if len(errs) != 1 {
+		t.Fatalf("malformed json should be rejected, got %v", errs)
+	}
+}
+
+func TestValidateIamPolicyDocumentJson(t *testing.T) {
+	ws, errs := validateIamPolicyDocumentJson(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["List*"],"Resource":["*"]}]}`, "policy_document")
+	if len(errs) != 0 || len(ws) != 1 {
+		t.Fatalf("invalid action should only be warned, got %v %v", ws, errs)
+	}
+	_, errs = validateIamPolicyDocumentJson(`{"Version":"2015-11-01"`, "policy_document")
+	if len(errs) != 1 {
+		t.Fatalf("malformed json should be rejected, got %v", errs)
+	}
+}
+
+func TestValidateIamTrustPolicyDocument(t *testing.T) {
+	_, errs := validateIamTrustPolicyDocument(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["kec"]}}]}`, "trust_policy_document")
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	_, errs = validateIamTrustPolicyDocument(`{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`, "trust_policy_document")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "principal is required") {
+		t.Fatalf("statement without principal should be rejected, got %v", errs)
+	}
+}
+
+func TestDecodeIamPolicyDocument(t *testing.T) {
+	document, err := decodeIamPolicyDocument(`%7B%22Version%22%3A%222015-11-01%22%7D`)
+	if err != nil || document != `{"Version":"2015-11-01"}` {
+		t.Fatalf("unexpected document %q, %v", document, err)
+	}
+	document, _ = decodeIamPolicyDocument(`{"Version": "2015-11-01"}`)
+	if document != `{"Version": "2015-11-01"}` {
+		t.Fatalf("the plain document should be kept, got %q", document)
+	}
+}
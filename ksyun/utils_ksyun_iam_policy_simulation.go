@@ -0,0 +1,270 @@
+package ksyun
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	iamDecisionAllowed      = "allowed"
+	iamDecisionExplicitDeny = "explicitDeny"
+	iamDecisionImplicitDeny = "implicitDeny"
+)
+
+type iamSimulationRequest struct {
+	Action   string
+	Resource string
+	Context  map[string][]string
+}
+
+type iamSimulationResult struct {
+	Decision         string
+	MatchedPolicy    int
+	MatchedStatement string
+}
+
+// simulateIamPolicies evaluates the request against the documents in the same way as IAM does:
+// an explicit deny wins, otherwise an allow is needed, and the request is denied implicitly at last.
+// The documents are validated first, an invalid document is an error rather than a wrong decision.
+func simulateIamPolicies(docs []*iamPolicyDocument, request iamSimulationRequest) (result iamSimulationResult, err error) {
+	result = iamSimulationResult{
+		Decision:      iamDecisionImplicitDeny,
+		MatchedPolicy: -1,
+	}
+	for i, doc := range docs {
+		if err = doc.validate(); err != nil {
+			return result, fmt.Errorf("policy_documents.%d: %s", i, err)
+		}
+		// the request is matched against the resources, a statement without them can never decide it
+		for j, st := range doc.Statement {
+			if len(st.Resource) == 0 && len(st.NotResource) == 0 {
+				return result, fmt.Errorf("policy_documents.%d: statement %d: at least one resource is required", i, j)
+			}
+		}
+	}
+	for i, doc := range docs {
+		for j, st := range doc.Statement {
+			var matched bool
+			matched, err = st.matches(request)
+			if err != nil {
+				return result, fmt.Errorf("policy_documents.%d: %s", i, err)
+			}
+			if !matched {
+				continue
+			}
+			name := st.Sid
+			if name == "" {
+				name = fmt.Sprintf("Statement.%d", j)
+			}
+			switch st.Effect {
+			case "Deny":
+				return iamSimulationResult{
+					Decision:         iamDecisionExplicitDeny,
+					MatchedPolicy:    i,
+					MatchedStatement: name,
+				}, nil
+			case "Allow":
+			default:
+				return result, fmt.Errorf("policy_documents.%d: %s: effect %q is not supported", i, name, st.Effect)
+			}
+			if result.Decision == iamDecisionImplicitDeny {
+				result = iamSimulationResult{
+					Decision:         iamDecisionAllowed,
+					MatchedPolicy:    i,
+					MatchedStatement: name,
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func iamWildcardMatch(pattern string, value string, ignoreCase bool) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.Replace(expr, `\*`, ".*", -1)
+	expr = strings.Replace(expr, `\?`, ".", -1)
+	expr = "^" + expr + "$"
+	if ignoreCase {
+		expr = "(?i)" + expr
+	}
+	return regexp.MustCompile(expr).MatchString(value)
+}
+
+func iamWildcardMatchAny(patterns []string, value string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if iamWildcardMatch(pattern, value, ignoreCase) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches checks the request against the statement, NotAction and NotResource match everything except the listed ones.
+func (st *iamPolicyStatement) matches(request iamSimulationRequest) (bool, error) {
+	if len(st.NotAction) > 0 {
+		if iamWildcardMatchAny(st.NotAction, request.Action, true) {
+			return false, nil
+		}
+	} else if !iamWildcardMatchAny(st.Action, request.Action, true) {
+		return false, nil
+	}
+	if len(st.NotResource) > 0 {
+		if iamWildcardMatchAny(st.NotResource, request.Resource, false) {
+			return false, nil
+		}
+	} else if !iamWildcardMatchAny(st.Resource, request.Resource, false) {
+		return false, nil
+	}
+	for operator, variables := range st.Condition {
+		for key, values := range variables {
+			matched, err := evaluateIamCondition(operator, values, request.Context[key])
+			if err != nil {
+				return false, fmt.Errorf("condition %s of %s: %s", operator, key, err)
+			}
+			if !matched {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// evaluateIamCondition compares the values of the request context with the values of the condition.
+// The missing key matches the negated operators only, unless the operator ends with IfExists.
+func evaluateIamCondition(operator string, conditionValues []string, contextValues []string) (bool, error) {
+	forAllValues := strings.HasPrefix(operator, "ForAllValues:")
+	forAnyValue := strings.HasPrefix(operator, "ForAnyValue:")
+	operator = strings.TrimPrefix(operator, "ForAllValues:")
+	operator = strings.TrimPrefix(operator, "ForAnyValue:")
+	ifExists := strings.HasSuffix(operator, "IfExists")
+	operator = strings.TrimSuffix(operator, "IfExists")
+
+	if operator == "Null" {
+		isNull := len(contextValues) == 0
+		for _, v := range conditionValues {
+			if strings.EqualFold(v, "true") == isNull {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	negated := strings.Contains(operator, "Not")
+	base := strings.Replace(operator, "Not", "", 1)
+	if len(contextValues) == 0 {
+		return ifExists || negated || forAllValues, nil
+	}
+
+	anyMatched := false
+	allMatched := true
+	for _, contextValue := range contextValues {
+		matched := false
+		for _, conditionValue := range conditionValues {
+			ok, err := compareIamConditionValue(base, conditionValue, contextValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				matched = true
+				break
+			}
+		}
+		anyMatched = anyMatched || matched
+		allMatched = allMatched && matched
+	}
+
+	if negated {
+		// ForAnyValue only needs one of the values not to match, the others need all of them not to match
+		if forAnyValue {
+			return !allMatched, nil
+		}
+		return !anyMatched, nil
+	}
+	if forAllValues {
+		return allMatched, nil
+	}
+	return anyMatched, nil
+}
+
+func compareIamConditionValue(operator string, conditionValue string, contextValue string) (bool, error) {
+	switch operator {
+	case "StringEquals":
+		return conditionValue == contextValue, nil
+	case "StringEqualsIgnoreCase":
+		return strings.EqualFold(conditionValue, contextValue), nil
+	case "StringLike":
+		return iamWildcardMatch(conditionValue, contextValue, false), nil
+	case "Bool":
+		return strings.EqualFold(conditionValue, contextValue), nil
+	case "IpAddress":
+		_, cidr, err := net.ParseCIDR(conditionValue)
+		if err != nil {
+			ip := net.ParseIP(conditionValue)
+			if ip == nil {
+				return false, fmt.Errorf("%q is not an ip address or cidr", conditionValue)
+			}
+			return ip.Equal(net.ParseIP(contextValue)), nil
+		}
+		ip := net.ParseIP(contextValue)
+		if ip == nil {
+			return false, fmt.Errorf("%q is not an ip address", contextValue)
+		}
+		return cidr.Contains(ip), nil
+	}
+	if strings.HasPrefix(operator, "Numeric") {
+		expect, err := strconv.ParseFloat(conditionValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a number", conditionValue)
+		}
+		actual, err := strconv.ParseFloat(contextValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a number", contextValue)
+		}
+		return compareIamConditionOrder(strings.TrimPrefix(operator, "Numeric"), actual-expect)
+	}
+	if strings.HasPrefix(operator, "Date") {
+		expect, err := parseIamConditionDate(conditionValue)
+		if err != nil {
+			return false, err
+		}
+		actual, err := parseIamConditionDate(contextValue)
+		if err != nil {
+			return false, err
+		}
+		return compareIamConditionOrder(strings.TrimPrefix(operator, "Date"), actual.Sub(expect).Seconds())
+	}
+	return false, fmt.Errorf("operator is not supported")
+}
+
+func compareIamConditionOrder(operator string, delta float64) (bool, error) {
+	switch operator {
+	case "Equals":
+		return delta == 0, nil
+	case "LessThan":
+		return delta < 0, nil
+	case "LessThanEquals":
+		return delta <= 0, nil
+	case "GreaterThan":
+		return delta > 0, nil
+	case "GreaterThanEquals":
+		return delta >= 0, nil
+	}
+	return false, fmt.Errorf("operator is not supported")
+}
+
+func parseIamConditionDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(epoch, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date", value)
+}
@@ -0,0 +1,130 @@
+package ksyun
+
+import (
+	"testing"
+)
+
+const testIamSimulationPolicy = `{
+  "Version": "2015-11-01",
+  "Statement": [
+    {"Sid": "ReadBucket", "Effect": "Allow", "Action": ["ks3:Get*", "ks3:List*"], "Resource": "krn:ksc:ks3:::tf-bucket/*"},
+    {"Sid": "DenyOutsideOffice", "Effect": "Deny", "Action": "ks3:*", "Resource": "*", "Condition": {"NotIpAddress": {"ksc:SourceIp": "10.0.0.0/8"}}},
+    {"Effect": "Allow", "Action": "kec:Describe*", "Resource": "*", "Condition": {"StringEqualsIfExists": {"ksc:RequestedRegion": "cn-beijing-6"}}}
+  ]
+}`
+
+func TestSimulateIamPolicies(t *testing.T) {
+	doc, err := parseIamPolicyDocument(testIamSimulationPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	office := map[string][]string{"ksc:SourceIp": {"10.1.1.1"}}
+	cases := []struct {
+		request   iamSimulationRequest
+		decision  string
+		statement string
+	}{
+		{iamSimulationRequest{"ks3:GetObject", "krn:ksc:ks3:::tf-bucket/a.txt", office}, iamDecisionAllowed, "ReadBucket"},
+		{iamSimulationRequest{"KS3:getobject", "krn:ksc:ks3:::tf-bucket/a.txt", office}, iamDecisionAllowed, "ReadBucket"},
+		{iamSimulationRequest{"ks3:PutObject", "krn:ksc:ks3:::tf-bucket/a.txt", office}, iamDecisionImplicitDeny, ""},
+		{iamSimulationRequest{"ks3:GetObject", "krn:ksc:ks3:::other/a.txt", office}, iamDecisionImplicitDeny, ""},
+		{iamSimulationRequest{"ks3:GetObject", "krn:ksc:ks3:::tf-bucket/a.txt", map[string][]string{"ksc:SourceIp": {"192.168.1.1"}}}, iamDecisionExplicitDeny, "DenyOutsideOffice"},
+		{iamSimulationRequest{"ks3:GetObject", "krn:ksc:ks3:::tf-bucket/a.txt", nil}, iamDecisionExplicitDeny, "DenyOutsideOffice"},
+		{iamSimulationRequest{"kec:DescribeInstances", "*", nil}, iamDecisionAllowed, "Statement.2"},
+		{iamSimulationRequest{"kec:DescribeInstances", "*", map[string][]string{"ksc:RequestedRegion": {"cn-shanghai-2"}}}, iamDecisionImplicitDeny, ""},
+	}
+	for i, c := range cases {
+		result, err := simulateIamPolicies([]*iamPolicyDocument{doc}, c.request)
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+		if result.Decision != c.decision || result.MatchedStatement != c.statement {
+			t.Fatalf("case %d: expect %s by %q, got %+v", i, c.decision, c.statement, result)
+		}
+	}
+}
+
+func TestSimulateIamPoliciesNotActionAndNotResource(t *testing.T) {
+	doc, err := parseIamPolicyDocument(`{"Statement":[
+    {"Sid": "AllButIam", "Effect": "Allow", "NotAction": "iam:*", "Resource": "*"},
+    {"Sid": "ProtectProd", "Effect": "Deny", "Action": "kec:Terminate*", "NotResource": "krn:ksc:kec:*:*:instance/dev-*"}
+  ]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		request   iamSimulationRequest
+		decision  string
+		statement string
+	}{
+		{iamSimulationRequest{"kec:DescribeInstances", "*", nil}, iamDecisionAllowed, "AllButIam"},
+		{iamSimulationRequest{"iam:CreateUser", "*", nil}, iamDecisionImplicitDeny, ""},
+		{iamSimulationRequest{"kec:TerminateInstances", "krn:ksc:kec:cn-beijing-6:2000012345:instance/dev-1", nil}, iamDecisionAllowed, "AllButIam"},
+		{iamSimulationRequest{"kec:TerminateInstances", "krn:ksc:kec:cn-beijing-6:2000012345:instance/prod-1", nil}, iamDecisionExplicitDeny, "ProtectProd"},
+	}
+	for i, c := range cases {
+		result, err := simulateIamPolicies([]*iamPolicyDocument{doc}, c.request)
+		if err != nil {
+			t.Fatalf("case %d: %s", i, err)
+		}
+		if result.Decision != c.decision || result.MatchedStatement != c.statement {
+			t.Fatalf("case %d: expect %s by %q, got %+v", i, c.decision, c.statement, result)
+		}
+	}
+}
+
+func TestSimulateIamPoliciesInvalidDocument(t *testing.T) {
+	for _, document := range []string{
+		`{"Statement":[{"Effect":"deny","Action":"kec:*","Resource":"*"}]}`,
+		`{"Statement":[{"Effect":"Allow","Action":"kec:*"}]}`,
+	} {
+		doc, err := parseIamPolicyDocument(document)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = simulateIamPolicies([]*iamPolicyDocument{doc}, iamSimulationRequest{"kec:RunInstances", "*", nil}); err == nil {
+			t.Fatalf("%s should be rejected", document)
+		}
+	}
+}
+
+func TestEvaluateIamCondition(t *testing.T) {
+	cases := []struct {
+		operator  string
+		condition []string
+		context   []string
+		expect    bool
+	}{
+		{"StringLike", []string{"tf-*"}, []string{"tf-user"}, true},
+		{"StringNotEquals", []string{"a"}, nil, true},
+		{"StringEquals", []string{"a"}, nil, false},
+		{"StringEqualsIgnoreCase", []string{"ABC"}, []string{"abc"}, true},
+		{"NumericLessThanEquals", []string{"3600"}, []string{"3600"}, true},
+		{"NumericGreaterThan", []string{"3600"}, []string{"60"}, false},
+		{"DateLessThan", []string{"2025-01-01T00:00:00Z"}, []string{"2024-06-01T00:00:00Z"}, true},
+		{"Bool", []string{"true"}, []string{"True"}, true},
+		{"IpAddress", []string{"10.0.0.0/8"}, []string{"10.2.3.4"}, true},
+		{"NotIpAddress", []string{"10.0.0.0/8"}, []string{"10.2.3.4"}, false},
+		{"Null", []string{"true"}, nil, true},
+		{"Null", []string{"false"}, nil, false},
+		{"ForAnyValue:StringEquals", []string{"a"}, []string{"a", "b"}, true},
+		{"ForAllValues:StringEquals", []string{"a"}, []string{"a", "b"}, false},
+		{"ForAllValues:StringEquals", []string{"a", "b"}, []string{"a", "b"}, true},
+		{"ForAnyValue:StringNotEquals", []string{"a"}, []string{"a", "b"}, true},
+		{"ForAnyValue:StringNotEquals", []string{"a", "b"}, []string{"a", "b"}, false},
+		{"ForAllValues:StringNotEquals", []string{"a"}, []string{"a", "b"}, false},
+		{"ForAllValues:StringNotEquals", []string{"c"}, []string{"a", "b"}, true},
+	}
+	for _, c := range cases {
+		result, err := evaluateIamCondition(c.operator, c.condition, c.context)
+		if err != nil {
+			t.Fatalf("%s %v %v: %s", c.operator, c.condition, c.context, err)
+		}
+		if result != c.expect {
+			t.Fatalf("%s %v %v: expect %t", c.operator, c.condition, c.context, c.expect)
+		}
+	}
+	if _, err := evaluateIamCondition("NumericEquals", []string{"one"}, []string{"1"}); err == nil {
+		t.Fatal("invalid number should be rejected")
+	}
+}
@@ -549,3 +549,193 @@ func importKpfsAcl(d *schema.ResourceData, meta interface{}) ([]*schema.Resource
 	}
 	return retD, nil
 }
+
+func importKecLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("launch_template_id", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	version, err := strconv.Atoi(items[1])
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("version number %q must be an integer", items[1])
+	}
+	err = d.Set("version_number", version)
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func importImageCopy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	d.SetId(items[0])
+	err = d.Set("destination_region", items[1])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func importKrdsBackup(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("db_instance_identifier", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func importKrdsProxy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("db_instance_identifier", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func importRedisBackup(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("cache_id", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func importMongodbBackup(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("instance_id", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func importMongodbBackupPolicy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := d.Set("instance_id", d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func importIamPolicy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamPolicyService := IamPolicyService{meta.(*KsyunClient)}
+	policyKrn, err := iamPolicyService.ReadPolicyKrnByName(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	err = d.Set("policy_name", d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	err = d.Set("policy_krn", policyKrn)
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func importIamRelationPolicy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	items := strings.Split(d.Id(), ":")
+	if len(items) != 4 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must be ${relation_type}:${name}:${policy_type}:${policy_name}")
+	}
+	relationType, err := strconv.Atoi(items[0])
+	if err != nil || (relationType != 1 && relationType != 2) {
+		return []*schema.ResourceData{d}, fmt.Errorf("relation_type must be 1 or 2, got %s", items[0])
+	}
+	iamRelationPolicyService := IamRelationPolicyService{meta.(*KsyunClient)}
+	data, err := iamRelationPolicyService.ReadRelationPolicy(relationType, items[1], items[3])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	if len(data) == 0 {
+		return []*schema.ResourceData{d}, fmt.Errorf("policy %s is not attached to %s", items[3], items[1])
+	}
+	accountId, err := iamRelationPolicyService.ReadRelationAccountId(relationType, items[1])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	_ = d.Set("relation_type", relationType)
+	_ = d.Set("name", items[1])
+	_ = d.Set("policy_type", items[2])
+	_ = d.Set("policy_name", items[3])
+	d.SetId(accountId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// importIamGroupMembership finds the users of the group, the api only lists the groups of a user.
+func importIamGroupMembership(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*KsyunClient)
+	iamUserService := IamUserService{client}
+	iamGroupService := IamGroupService{client}
+	users, err := iamUserService.ReadUsers(map[string]interface{}{})
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	var userNames []string
+	for _, item := range users {
+		userName, _ := item.(map[string]interface{})["UserName"].(string)
+		groups, err := iamGroupService.ReadIamGroupsForUser(userName)
+		if err != nil {
+			return []*schema.ResourceData{d}, err
+		}
+		for _, group := range groups {
+			if group == d.Id() {
+				userNames = append(userNames, userName)
+				break
+			}
+		}
+	}
+	_ = d.Set("group_name", d.Id())
+	err = d.Set("user_names", userNames)
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func importIamAccessKey(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) < 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("user_name", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,60 @@
+package ksyun
+
+import (
+	"github.com/KscSDK/ksc-sdk-go/ksc"
+	"github.com/KscSDK/ksc-sdk-go/ksc/kscquery"
+	"github.com/KscSDK/ksc-sdk-go/ksc/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// ksyunOpenApiCall calls an OpenAPI action with a generated sdk client.
+// Some actions have not been generated into ksc-sdk-go yet, so the request is built here
+// and it will be signed and unmarshalled by the handlers of the given client.
+func ksyunOpenApiCall(c *client.Client, action string, input *map[string]interface{}) (*map[string]interface{}, error) {
+	op := &request.Operation{
+		Name:       action,
+		HTTPMethod: "GET",
+		HTTPPath:   "/",
+	}
+	if input == nil {
+		input = &map[string]interface{}{}
+	}
+	output := &map[string]interface{}{}
+	req := c.NewRequest(op, input, output)
+	return output, req.Send()
+}
+
+// newKsyunOpenApiClient creates a client for the service which has not been generated into ksc-sdk-go,
+// it is initialized in the same way as the generated clients.
+func newKsyunOpenApiClient(p client.ConfigProvider, cfg *ksc.Config, info *utils.UrlInfo, service string, apiVersion string) *client.Client {
+	c := p.ClientConfig(service, &aws.Config{Region: cfg.Region})
+	endpoint := utils.Url(info, utils.ServiceInfo{
+		Service: service,
+		Region:  c.SigningRegion,
+	})
+	svc := client.New(
+		*c.Config,
+		metadata.ClientInfo{
+			ServiceName:   service,
+			ServiceID:     service,
+			SigningName:   c.SigningName,
+			SigningRegion: c.SigningRegion,
+			Endpoint:      endpoint,
+			APIVersion:    apiVersion,
+		},
+		c.Handlers,
+	)
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	svc.Handlers.Build.Remove(corehandlers.SDKVersionUserAgentHandler)
+	svc.Handlers.Build.PushBackNamed(ksc.SDKVersionUserAgentHandler)
+	svc.Handlers.Build.PushBackNamed(kscquery.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(kscquery.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(kscquery.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(kscquery.UnmarshalErrorHandler)
+	return svc
+}
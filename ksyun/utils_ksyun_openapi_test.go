@@ -0,0 +1,75 @@
+package ksyun
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/KscSDK/ksc-sdk-go/ksc"
+	"github.com/KscSDK/ksc-sdk-go/ksc/utils"
+	"github.com/KscSDK/ksc-sdk-go/service/kec"
+)
+
+func TestKsyunOpenApiCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("Action") != "DescribeLaunchTemplates" {
+			t.Errorf("unexpected action %q", q.Get("Action"))
+		}
+		if q.Get("LaunchTemplateId.1") != "lt-123" {
+			t.Errorf("unexpected parameter %q", q.Get("LaunchTemplateId.1"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"RequestId":"r-1","LaunchTemplateSet":[{"LaunchTemplateId":"lt-123"}]}`))
+	}))
+	defer srv.Close()
+
+	region := "cn-beijing-6"
+	conn := kec.SdkNew(ksc.NewClient("ak", "sk"), &ksc.Config{Region: &region})
+	conn.Endpoint = srv.URL
+
+	resp, err := ksyunOpenApiCall(conn.Client, "DescribeLaunchTemplates", &map[string]interface{}{
+		"LaunchTemplateId.1": "lt-123",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := getSdkValue("LaunchTemplateSet.0.LaunchTemplateId", *resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "lt-123" {
+		t.Fatalf("expected lt-123, got %v", id)
+	}
+}
+
+func TestNewKsyunOpenApiClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("Action") != "QueryProductPrice" {
+			t.Errorf("unexpected action %q", q.Get("Action"))
+		}
+		if q.Get("Version") != "2020-01-14" {
+			t.Errorf("unexpected version %q", q.Get("Version"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"RequestId":"r-1","Price":{"OriginalPrice":1.5}}`))
+	}))
+	defer srv.Close()
+
+	region := "cn-beijing-6"
+	conn := newKsyunOpenApiClient(ksc.NewClient("ak", "sk"), &ksc.Config{Region: &region}, &utils.UrlInfo{}, "trade", "2020-01-14")
+	conn.Endpoint = srv.URL
+
+	resp, err := ksyunOpenApiCall(conn, "QueryProductPrice", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	price, err := getSdkValue("Price.OriginalPrice", *resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price != 1.5 {
+		t.Fatalf("expected 1.5, got %v", price)
+	}
+}